@@ -0,0 +1,47 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	gh "github.com/cli/go-gh/v2"
+)
+
+// ghExtensionEnv is set when the binary is invoked by gh as an extension (gh actions-dash)
+const ghExtensionEnv = "GH_EXTENSION"
+
+func init() {
+	// gh経由の実行ではヘルプの使用例を gh actions-dash に合わせる
+	if isGhExtension() {
+		rootCmd.Use = "gh actions-dash [owner/repo]"
+	}
+}
+
+// isGhExtension reports whether the binary was invoked as a gh extension
+func isGhExtension() bool {
+	return os.Getenv(ghExtensionEnv) != ""
+}
+
+// ghRepoInfo returns the owner and name of the repository that gh resolves for the current directory
+func ghRepoInfo() (string, string, error) {
+	stdout, stderr, err := gh.Exec("repo", "view", "--json", "owner,name")
+	if err != nil {
+		return "", "", fmt.Errorf("gh repo view failed: %w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+
+	var info struct {
+		Owner struct {
+			Login string `json:"login"`
+		} `json:"owner"`
+		Name string `json:"name"`
+	}
+	if err := json.Unmarshal(stdout.Bytes(), &info); err != nil {
+		return "", "", fmt.Errorf("failed to parse gh repo view output: %w", err)
+	}
+	if info.Owner.Login == "" || info.Name == "" {
+		return "", "", fmt.Errorf("gh repo view returned no repository")
+	}
+	return info.Owner.Login, info.Name, nil
+}
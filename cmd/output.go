@@ -0,0 +1,66 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/ryo246912/gh-actions-dash/internal/github"
+	"github.com/ryo246912/gh-actions-dash/internal/models"
+)
+
+const (
+	outputJSON  = "json"
+	outputTable = "table"
+
+	// outputPerPage is the number of runs fetched in non-interactive mode when --per-page is not given
+	outputPerPage = 100
+)
+
+// writeRuns fetches the latest perPage workflow runs matching the filter and writes them in the given format
+func writeRuns(w io.Writer, client *github.Client, owner, repo, format string, perPage int, filter github.RunFilter) error {
+	runs, _, err := client.GetAllWorkflowRunsPaginated(owner, repo, 1, perPage, filter)
+	if err != nil {
+		return fmt.Errorf("failed to get workflow runs: %w", err)
+	}
+
+	switch format {
+	case outputJSON:
+		return writeRunsJSON(w, runs)
+	case outputTable:
+		return writeRunsTable(w, runs)
+	default:
+		return fmt.Errorf("unsupported output format: %s", format)
+	}
+}
+
+// writeRunsJSON writes workflow runs as indented JSON
+func writeRunsJSON(w io.Writer, runs []models.WorkflowRun) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(runs)
+}
+
+// writeRunsTable writes workflow runs as a plain-text table
+func writeRunsTable(w io.Writer, runs []models.WorkflowRun) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(tw, "ID\tNAME\tNUMBER\tSTATUS\tBRANCH\tEVENT\tACTOR\tCREATED")
+	for _, run := range runs {
+		status := run.Status
+		if run.Status == "completed" {
+			status = run.Conclusion
+		}
+		_, _ = fmt.Fprintf(tw, "%d\t%s\t#%d\t%s\t%s\t%s\t%s\t%s\n",
+			run.ID,
+			run.Name,
+			run.RunNumber,
+			status,
+			run.HeadBranch,
+			run.Event,
+			run.Actor.Login,
+			run.CreatedAt.Format("2006-01-02 15:04:05"),
+		)
+	}
+	return tw.Flush()
+}
@@ -2,31 +2,128 @@ package cmd
 
 import (
 	"fmt"
+	"io"
+	"log/slog"
 	"os"
+	"strings"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+	"github.com/ryo246912/gh-actions-dash/internal/bookmarks"
+	"github.com/ryo246912/gh-actions-dash/internal/config"
 	"github.com/ryo246912/gh-actions-dash/internal/git"
 	"github.com/ryo246912/gh-actions-dash/internal/github"
+	"github.com/ryo246912/gh-actions-dash/internal/history"
+	"github.com/ryo246912/gh-actions-dash/internal/recentrepos"
 	"github.com/ryo246912/gh-actions-dash/internal/tui"
 	"github.com/spf13/cobra"
 )
 
 var (
-	owner string
-	repo  string
+	owner              string
+	repo               string
+	refreshInterval    int
+	outputFormat       string
+	hostname           string
+	absoluteTime       bool
+	perPage            int
+	since              string
+	until              string
+	branch             string
+	noColor            bool
+	watch              bool
+	debug              bool
+	logFile            string
+	concurrentRequests int
+)
+
+const (
+	// maxPerPage is the maximum page size accepted by the GitHub API
+	maxPerPage = 100
 )
 
 // rootCmd represents the base command when called without any subcommands
 var rootCmd = &cobra.Command{
-	Use:   "gh-actions-dash",
+	Use:   "gh-actions-dash [owner/repo]",
 	Short: "A TUI for GitHub Actions",
-	Long:  `A terminal user interface for managing and viewing GitHub Actions workflows.`,
+	Long: `A terminal user interface for managing and viewing GitHub Actions workflows.
+
+Environment variables:
+  GH_TOKEN, GITHUB_TOKEN            Token for github.com used instead of the gh credentials
+  GH_ENTERPRISE_TOKEN               Token for a GitHub Enterprise Server host (--hostname)
+  GH_ACTIONS_DASH_MAX_RETRIES       Number of retries of a failed API request, 0-10 (default 3)
+  GH_ACTIONS_DASH_INITIAL_DELAY_MS  Delay before the first retry in milliseconds, up to 600000 (default 1000)
+  GH_ACTIONS_DASH_MAX_DELAY_MS      Maximum delay between retries in milliseconds, from the initial delay
+                                    up to 600000 (default 10000)`,
+	Args: cobra.MaximumNArgs(1),
+	PersistentPreRun: func(cmd *cobra.Command, args []string) {
+		// Styles created after this render without colors
+		if colorDisabled() {
+			lipgloss.SetColorProfile(termenv.Ascii)
+		}
+	},
 	RunE: func(cmd *cobra.Command, args []string) error {
+		// Positional owner/repo argument
+		if len(args) == 1 {
+			if cmd.Flags().Changed("owner") || cmd.Flags().Changed("repo") {
+				return fmt.Errorf("cannot use the owner/repo argument together with --owner or --repo")
+			}
+			var err error
+			owner, repo, err = parseRepoFlag(args[0])
+			if err != nil {
+				return err
+			}
+		}
+
+		// Load config file
+		cfg, err := config.LoadDefault()
+		if err != nil {
+			return fmt.Errorf("failed to load config: %w", err)
+		}
+		keyMap := cfg.KeyMap()
+
+		// Flags take priority over config file values
+		if !cmd.Flags().Changed("refresh") {
+			refreshInterval = cfg.RefreshIntervalSeconds
+		}
+		if refreshInterval < 0 {
+			return fmt.Errorf("invalid --refresh value %d: must be 0 or greater", refreshInterval)
+		}
+		if !cmd.Flags().Changed("concurrent-requests") && cfg.ConcurrentRequests > 0 {
+			concurrentRequests = cfg.ConcurrentRequests
+		}
+		if concurrentRequests < 1 {
+			return fmt.Errorf("invalid --concurrent-requests value %d: must be 1 or greater", concurrentRequests)
+		}
+		if perPage < 1 || perPage > maxPerPage {
+			return fmt.Errorf("invalid --per-page value %d: must be between 1 and %d", perPage, maxPerPage)
+		}
+		created, err := parseTimeRange(since, until)
+		if err != nil {
+			return err
+		}
+		if outputFormat != "" && outputFormat != outputJSON && outputFormat != outputTable {
+			return fmt.Errorf("invalid --output value %q: must be %q or %q", outputFormat, outputJSON, outputTable)
+		}
+
 		// Initialize GitHub client
-		client, err := github.NewClient()
+		client, err := github.NewClientWithHostname(hostname)
 		if err != nil {
 			return fmt.Errorf("failed to create GitHub client: %w", err)
 		}
+		_, _ = fmt.Fprintf(os.Stderr, "Authenticating with %s\n", client.AuthMethod())
+		client.SetConcurrentRequests(concurrentRequests)
+		if debug {
+			client.EnableDebugLog()
+		}
+		logger, closeLog, err := openLogFile(logFile)
+		if err != nil {
+			return err
+		}
+		defer closeLog()
+		client.SetLogger(logger)
 
 		// Verify authentication
 		_, err = client.GetCurrentUser()
@@ -34,6 +131,18 @@ var rootCmd = &cobra.Command{
 			return fmt.Errorf("failed to authenticate with GitHub: %w", err)
 		}
 
+		// gh拡張として実行された場合はghが解決するリポジトリをgitのリモートより優先する
+		if (owner == "" || repo == "") && isGhExtension() {
+			if ghOwner, ghRepo, err := ghRepoInfo(); err == nil {
+				if owner == "" {
+					owner = ghOwner
+				}
+				if repo == "" {
+					repo = ghRepo
+				}
+			}
+		}
+
 		// If no owner/repo specified, try to get from current directory
 		if owner == "" || repo == "" {
 			repoInfo, err := git.GetCurrentRepoInfo()
@@ -49,19 +158,151 @@ var rootCmd = &cobra.Command{
 			}
 		}
 
+		// Non-interactive output mode
+		if outputFormat != "" {
+			// --per-pageを指定しなければAPIの上限まで取得する
+			outputRuns := outputPerPage
+			if cmd.Flags().Changed("per-page") {
+				outputRuns = perPage
+			}
+			return writeRuns(os.Stdout, client, owner, repo, outputFormat, outputRuns, github.RunFilter{Created: created, Branch: branch})
+		}
+
+		// Load log bookmarks
+		// 壊れたファイルや読めないファイルがあっても起動は続け、空の状態から始める
+		marks, err := bookmarks.LoadDefault()
+		if err != nil {
+			warnf(logger, "failed to load bookmarks, starting with no bookmarks: %v", err)
+			if path, err := bookmarks.DefaultPath(); err == nil {
+				marks = bookmarks.New(path)
+			}
+		}
+
+		// Load log search history
+		searchHistory, err := history.LoadDefault()
+		if err != nil {
+			warnf(logger, "failed to load search history, starting with an empty history: %v", err)
+		}
+
+		// Load recently visited repositories
+		recentRepos, err := recentrepos.LoadDefault()
+		if err != nil {
+			warnf(logger, "failed to load recent repositories, starting with an empty list: %v", err)
+		}
+
 		// Create TUI app
-		app := tui.NewApp(client, owner, repo)
+		app := tui.NewApp(client, owner, repo, keyMap, tui.Options{
+			RefreshInterval:   time.Duration(refreshInterval) * time.Second,
+			AbsoluteTime:      absoluteTime,
+			PerPage:           perPage,
+			Created:           created,
+			Branch:            branch,
+			ListDensity:       cfg.ListDensity,
+			Columns:           cfg.Columns,
+			StaleRunThreshold: time.Duration(cfg.StaleRunMinutes) * time.Minute,
+			Bookmarks:         marks,
+			NoColor:           colorDisabled(),
+			Watch:             watch,
+			SearchHistory:     searchHistory,
+			RecentRepos:       recentRepos,
+			Logger:            logger,
+		})
 
 		// Start the TUI
-		p := tea.NewProgram(app, tea.WithAltScreen())
+		p := tea.NewProgram(app, tea.WithAltScreen(), tea.WithMouseCellMotion())
 		if _, err := p.Run(); err != nil {
 			return fmt.Errorf("error running TUI: %w", err)
 		}
 
+		// 終了時に検索履歴を保存する
+		if err := history.SaveDefault(app.SearchHistory()); err != nil {
+			return fmt.Errorf("failed to save search history: %w", err)
+		}
+
+		// 終了時に表示中のリポジトリを最近開いたリポジトリに追加する
+		if err := recentrepos.SaveDefault(app.RecentRepos()); err != nil {
+			return fmt.Errorf("failed to save recent repositories: %w", err)
+		}
+
 		return nil
 	},
 }
 
+// warnf prints a warning to stderr and the log file without stopping the command
+func warnf(logger *slog.Logger, format string, args ...any) {
+	message := fmt.Sprintf(format, args...)
+	_, _ = fmt.Fprintf(os.Stderr, "Warning: %s\n", message)
+	logger.Warn(message)
+}
+
+// openLogFile returns a JSON logger appending to path and a function closing the file.
+// An empty path discards the logs.
+func openLogFile(path string) (*slog.Logger, func(), error) {
+	if path == "" {
+		return slog.New(slog.NewJSONHandler(io.Discard, nil)), func() {}, nil
+	}
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to open log file: %w", err)
+	}
+	return slog.New(slog.NewJSONHandler(f, nil)), func() { _ = f.Close() }, nil
+}
+
+// colorDisabled reports whether colors are disabled by --no-color or the NO_COLOR environment variable
+func colorDisabled() bool {
+	return noColor || os.Getenv("NO_COLOR") != ""
+}
+
+// parseRepoFlag parses a repository given in "owner/repo" format
+func parseRepoFlag(value string) (string, string, error) {
+	parts := strings.Split(value, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("invalid repository %q: must be in owner/repo format", value)
+	}
+	return parts[0], parts[1], nil
+}
+
+// parseTimeRange parses the --since and --until flag values
+func parseTimeRange(since, until string) (github.TimeRange, error) {
+	var created github.TimeRange
+	var err error
+
+	if since != "" {
+		created.Since, err = parseTimeFlag(since, false)
+		if err != nil {
+			return created, fmt.Errorf("invalid --since value %q: %w", since, err)
+		}
+	}
+	if until != "" {
+		created.Until, err = parseTimeFlag(until, true)
+		if err != nil {
+			return created, fmt.Errorf("invalid --until value %q: %w", until, err)
+		}
+	}
+	if !created.Since.IsZero() && !created.Until.IsZero() && created.Until.Before(created.Since) {
+		return created, fmt.Errorf("--until (%s) must not be before --since (%s)", until, since)
+	}
+
+	return created, nil
+}
+
+// parseTimeFlag parses an ISO-8601 timestamp or a YYYY-MM-DD date.
+// A date used as an upper bound covers the whole day.
+func parseTimeFlag(value string, endOfDay bool) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+
+	t, err := time.Parse(time.DateOnly, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("must be in YYYY-MM-DD or ISO-8601 (e.g. 2006-01-02T15:04:05Z) format")
+	}
+	if endOfDay {
+		t = t.Add(24*time.Hour - time.Second)
+	}
+	return t, nil
+}
+
 // Execute adds all child commands to the root command and sets flags appropriately
 func Execute() {
 	err := rootCmd.Execute()
@@ -71,6 +312,19 @@ func Execute() {
 }
 
 func init() {
+	rootCmd.PersistentFlags().BoolVar(&noColor, "no-color", false, "Disable colors and text styling (also enabled by the NO_COLOR environment variable)")
 	rootCmd.Flags().StringVarP(&owner, "owner", "o", "", "Repository owner")
 	rootCmd.Flags().StringVarP(&repo, "repo", "r", "", "Repository name")
+	rootCmd.Flags().IntVar(&refreshInterval, "refresh", 0, "Auto-refresh interval in seconds (0 disables auto-refresh)")
+	rootCmd.Flags().StringVar(&outputFormat, "output", "", "Print workflow runs without the TUI (json or table, 100 runs unless --per-page is given)")
+	rootCmd.Flags().StringVar(&hostname, "hostname", "", "GitHub hostname (for GitHub Enterprise Server)")
+	rootCmd.Flags().IntVar(&perPage, "per-page", 30, "Number of workflows and runs per page (1-100)")
+	rootCmd.Flags().StringVar(&since, "since", "", "Only show runs created at or after this time (YYYY-MM-DD or ISO-8601)")
+	rootCmd.Flags().StringVar(&until, "until", "", "Only show runs created at or before this time (YYYY-MM-DD or ISO-8601)")
+	rootCmd.Flags().StringVar(&branch, "branch", "", "Only show runs of this branch in the all runs view and --output (Esc on the branch input shows all branches)")
+	rootCmd.Flags().BoolVar(&absoluteTime, "absolute-time", false, "Show absolute timestamps instead of relative ones")
+	rootCmd.Flags().IntVar(&concurrentRequests, "concurrent-requests", github.DefaultConcurrentRequests, "Maximum number of API requests running at the same time")
+	rootCmd.Flags().BoolVar(&debug, "debug", false, "Record the last API requests and show them with ctrl+d")
+	rootCmd.Flags().StringVar(&logFile, "log-file", "", "Append API requests and view transitions to this file as JSON logs")
+	rootCmd.Flags().BoolVar(&watch, "watch", false, "Follow the latest in-progress run every 15 seconds and ring the bell when all runs complete")
 }
@@ -0,0 +1,98 @@
+package cmd
+
+import (
+	"fmt"
+	"runtime"
+	runtimedebug "runtime/debug"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// version is the release version, set at build time with
+// -ldflags "-X github.com/ryo246912/gh-actions-dash/cmd.version=v1.2.3"
+var version = ""
+
+// unknownBuildInfo is shown for build information that is not embedded in the binary
+const unknownBuildInfo = "unknown"
+
+// buildInfo represents the version information of the binary
+type buildInfo struct {
+	Version   string
+	Commit    string
+	Date      string
+	GoVersion string
+}
+
+// readBuildInfo returns the version set with -ldflags and the VCS information embedded by the Go toolchain
+func readBuildInfo() buildInfo {
+	info := buildInfo{
+		Version:   version,
+		Commit:    unknownBuildInfo,
+		Date:      unknownBuildInfo,
+		GoVersion: runtime.Version(),
+	}
+
+	bi, ok := runtimedebug.ReadBuildInfo()
+	if !ok {
+		if info.Version == "" {
+			info.Version = unknownBuildInfo
+		}
+		return info
+	}
+
+	// go install ...@v1.2.3 でビルドした場合はモジュールのバージョンを使う
+	if info.Version == "" && bi.Main.Version != "" && bi.Main.Version != "(devel)" {
+		info.Version = bi.Main.Version
+	}
+	if info.Version == "" {
+		info.Version = unknownBuildInfo
+	}
+
+	modified := false
+	for _, setting := range bi.Settings {
+		switch setting.Key {
+		case "vcs.revision":
+			info.Commit = setting.Value
+		case "vcs.time":
+			info.Date = setting.Value
+		case "vcs.modified":
+			modified = setting.Value == "true"
+		}
+	}
+	if modified && info.Commit != unknownBuildInfo {
+		info.Commit += "-dirty"
+	}
+	return info
+}
+
+// String formats the build information printed by --version and the version subcommand
+func (b buildInfo) String() string {
+	var s strings.Builder
+	fmt.Fprintf(&s, "gh-actions-dash %s\n", b.Version)
+	fmt.Fprintf(&s, "commit: %s\n", b.Commit)
+	fmt.Fprintf(&s, "built:  %s\n", b.Date)
+	fmt.Fprintf(&s, "go:     %s\n", b.GoVersion)
+	if b.Version == unknownBuildInfo || b.Commit == unknownBuildInfo {
+		s.WriteString("\nVersion information is only available in release builds (not with go run).\n")
+	}
+	return s.String()
+}
+
+var versionCmd = &cobra.Command{
+	Use:   "version",
+	Short: "Print the version, commit SHA, build date and Go version",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		_, _ = fmt.Fprint(cmd.OutOrStdout(), readBuildInfo())
+	},
+}
+
+func init() {
+	info := readBuildInfo()
+	rootCmd.Version = info.Version
+	rootCmd.SetVersionTemplate(info.String())
+	// --helpにもバージョンを表示する
+	rootCmd.Long += "\n\nVersion: " + info.Version
+	rootCmd.AddCommand(versionCmd)
+}
@@ -0,0 +1,36 @@
+package atomicfile
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestWrite(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "nested", "data.json")
+
+	if err := Write(path, []byte("first")); err != nil {
+		t.Fatalf("Write() to a missing directory failed: %v", err)
+	}
+	if err := Write(path, []byte("second")); err != nil {
+		t.Fatalf("Write() over an existing file failed: %v", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "second" {
+		t.Errorf("file content = %q, want %q", data, "second")
+	}
+
+	// 一時ファイルが残っていないこと
+	entries, err := os.ReadDir(filepath.Dir(path))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 {
+		t.Errorf("directory has %d entries, want only data.json", len(entries))
+	}
+}
@@ -0,0 +1,110 @@
+package bookmarks
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/ryo246912/gh-actions-dash/internal/atomicfile"
+)
+
+// Store holds the bookmarked log lines of workflow runs and persists them to a JSON file
+type Store struct {
+	path string
+	runs map[int64][]int // run ID -> 1-based line numbers in ascending order
+}
+
+// DefaultPath returns the default bookmarks file path (~/.local/share/gh-actions-dash/bookmarks.json)
+func DefaultPath() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get home directory: %w", err)
+	}
+	return filepath.Join(home, ".local", "share", "gh-actions-dash", "bookmarks.json"), nil
+}
+
+// New returns an empty store saved to the bookmarks file at path
+func New(path string) *Store {
+	return &Store{path: path, runs: make(map[int64][]int)}
+}
+
+// Load reads the bookmarks file at path. A missing file returns an empty store.
+func Load(path string) (*Store, error) {
+	s := New(path)
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return s, nil
+		}
+		return nil, fmt.Errorf("failed to read bookmarks file %s: %w", path, err)
+	}
+
+	if err := json.Unmarshal(data, &s.runs); err != nil {
+		return nil, fmt.Errorf("failed to parse bookmarks file %s: %w", path, err)
+	}
+	for runID, lines := range s.runs {
+		slices.Sort(lines)
+		s.runs[runID] = slices.Compact(lines)
+	}
+
+	return s, nil
+}
+
+// LoadDefault reads the bookmarks file from the default path
+func LoadDefault() (*Store, error) {
+	path, err := DefaultPath()
+	if err != nil {
+		return nil, err
+	}
+	return Load(path)
+}
+
+// Lines returns the bookmarked line numbers of a run in ascending order
+func (s *Store) Lines(runID int64) []int {
+	return slices.Clone(s.runs[runID])
+}
+
+// Add bookmarks a line of a run and saves the file.
+// It returns false if the line was already bookmarked.
+func (s *Store) Add(runID int64, line int) (bool, error) {
+	lines := s.runs[runID]
+	i, found := slices.BinarySearch(lines, line)
+	if found {
+		return false, nil
+	}
+	s.runs[runID] = slices.Insert(lines, i, line)
+	return true, s.save()
+}
+
+// Remove deletes a bookmarked line of a run and saves the file
+func (s *Store) Remove(runID int64, line int) error {
+	lines := s.runs[runID]
+	i, found := slices.BinarySearch(lines, line)
+	if !found {
+		return nil
+	}
+	lines = slices.Delete(lines, i, i+1)
+	if len(lines) == 0 {
+		delete(s.runs, runID)
+	} else {
+		s.runs[runID] = lines
+	}
+	return s.save()
+}
+
+// save writes the bookmarks atomically by renaming a temporary file over the bookmarks file
+func (s *Store) save() error {
+	data, err := json.MarshalIndent(s.runs, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode bookmarks: %w", err)
+	}
+
+	if err := atomicfile.Write(s.path, data); err != nil {
+		return fmt.Errorf("failed to write bookmarks file %s: %w", s.path, err)
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+package browser
+
+import (
+	"errors"
+	"fmt"
+	"os/exec"
+	"runtime"
+)
+
+// ErrNoOpener is returned when no command to open a browser is available on this platform
+var ErrNoOpener = errors.New("no browser opener found")
+
+// Open opens url in the default browser
+func Open(url string) error {
+	name, args := openerCommand(url)
+	if name == "" {
+		return ErrNoOpener
+	}
+	if _, err := exec.LookPath(name); err != nil {
+		return fmt.Errorf("%w: %s", ErrNoOpener, name)
+	}
+
+	// ブラウザの終了は待たない
+	if err := exec.Command(name, args...).Start(); err != nil {
+		return fmt.Errorf("failed to open browser: %w", err)
+	}
+	return nil
+}
+
+// openerCommand returns the command used to open a URL on the current platform
+func openerCommand(url string) (string, []string) {
+	switch runtime.GOOS {
+	case "darwin":
+		return "open", []string{url}
+	case "windows":
+		return "rundll32", []string{"url.dll,FileProtocolHandler", url}
+	case "linux", "freebsd", "openbsd", "netbsd":
+		return "xdg-open", []string{url}
+	default:
+		return "", nil
+	}
+}
@@ -0,0 +1,66 @@
+package clipboard
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
+
+// ErrNoClipboard is returned when no clipboard command is available on this platform
+var ErrNoClipboard = errors.New("no clipboard command found")
+
+// FallbackPath is the file written when no clipboard command is available
+var FallbackPath = filepath.Join(os.TempDir(), "gh-actions-dash-clipboard.txt")
+
+// Write copies text to the system clipboard
+func Write(text string) error {
+	for _, candidate := range copyCommands() {
+		if _, err := exec.LookPath(candidate[0]); err != nil {
+			continue
+		}
+		cmd := exec.Command(candidate[0], candidate[1:]...)
+		cmd.Stdin = strings.NewReader(text)
+		if err := cmd.Run(); err != nil {
+			return fmt.Errorf("failed to copy to clipboard with %s: %w", candidate[0], err)
+		}
+		return nil
+	}
+	return ErrNoClipboard
+}
+
+// WriteOrFallback copies text to the clipboard, or writes it to FallbackPath when no
+// clipboard command is available. It returns the fallback path if it was used.
+func WriteOrFallback(text string) (string, error) {
+	err := Write(text)
+	if !errors.Is(err, ErrNoClipboard) {
+		return "", err
+	}
+	if err := os.WriteFile(FallbackPath, []byte(text), 0o600); err != nil {
+		return "", fmt.Errorf("failed to write %s: %w", FallbackPath, err)
+	}
+	return FallbackPath, nil
+}
+
+// copyCommands returns the clipboard commands to try on the current platform, in order of preference
+func copyCommands() [][]string {
+	switch runtime.GOOS {
+	case "darwin":
+		return [][]string{{"pbcopy"}}
+	case "windows":
+		return [][]string{{"clip"}}
+	default:
+		var commands [][]string
+		// Waylandではwl-copyを優先する
+		if os.Getenv("WAYLAND_DISPLAY") != "" {
+			commands = append(commands, []string{"wl-copy"})
+		}
+		return append(commands,
+			[]string{"xclip", "-selection", "clipboard"},
+			[]string{"xsel", "--clipboard", "--input"},
+		)
+	}
+}
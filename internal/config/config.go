@@ -0,0 +1,256 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// List density names accepted by list_density
+const (
+	ListDensityCompact     = "compact"
+	ListDensityComfortable = "comfortable"
+	ListDensitySpacious    = "spacious"
+)
+
+// ListDensities lists the list density names from the most compact
+var ListDensities = []string{ListDensityCompact, ListDensityComfortable, ListDensitySpacious}
+
+// Run list column names accepted by columns
+const (
+	ColumnName     = "name"
+	ColumnEvent    = "event"
+	ColumnStatus   = "status"
+	ColumnBranch   = "branch"
+	ColumnActor    = "actor"
+	ColumnSHA      = "sha"
+	ColumnPR       = "pr"
+	ColumnDuration = "duration"
+	ColumnTime     = "time"
+)
+
+// RunListColumnNames lists the run list column names in their default order
+var RunListColumnNames = []string{ColumnName, ColumnEvent, ColumnStatus, ColumnBranch, ColumnActor, ColumnSHA, ColumnPR, ColumnDuration, ColumnTime}
+
+// RunListColumns is the ordered list of columns shown in run lists
+type RunListColumns []string
+
+// validate checks that every column is known and listed only once
+func (c RunListColumns) validate() error {
+	seen := make(map[string]bool, len(c))
+	for _, column := range c {
+		if !slices.Contains(RunListColumnNames, column) {
+			return fmt.Errorf("unknown column %q: must be one of %s", column, strings.Join(RunListColumnNames, ", "))
+		}
+		if seen[column] {
+			return fmt.Errorf("column %q is listed more than once", column)
+		}
+		seen[column] = true
+	}
+	return nil
+}
+
+// Config represents the user configuration file
+type Config struct {
+	// RefreshIntervalSeconds is the auto-refresh interval in seconds (0 disables auto-refresh)
+	RefreshIntervalSeconds int `yaml:"refresh_interval_seconds"`
+	// ListDensity is the density of run lists (compact, comfortable or spacious)
+	ListDensity string `yaml:"list_density"`
+	// Columns is the ordered list of columns shown in run lists (empty shows all columns)
+	Columns RunListColumns `yaml:"columns"`
+	// ConcurrentRequests is the number of API requests that may run at the same time (0 uses the default)
+	ConcurrentRequests int `yaml:"concurrent_requests"`
+	// StaleRunMinutes is how long an in-progress run may go without updates before it is flagged as stuck (0 uses the default)
+	StaleRunMinutes int `yaml:"stale_run_minutes"`
+	// Keys maps action names (up, down, refresh, ...) to the keys bound to them
+	Keys map[string]KeyList `yaml:"keys"`
+}
+
+// KeyList is a list of key names that can be written as a single string or a list in YAML
+type KeyList []string
+
+// UnmarshalYAML implements yaml.Unmarshaler
+func (k *KeyList) UnmarshalYAML(value *yaml.Node) error {
+	if value.Kind == yaml.ScalarNode {
+		*k = KeyList{value.Value}
+		return nil
+	}
+	var keys []string
+	if err := value.Decode(&keys); err != nil {
+		return err
+	}
+	*k = keys
+	return nil
+}
+
+// DefaultPath returns the default config file path (~/.config/gh-actions-dash/config.yaml)
+func DefaultPath() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get home directory: %w", err)
+	}
+	return filepath.Join(home, ".config", "gh-actions-dash", "config.yaml"), nil
+}
+
+// Load reads the config file at path. A missing file returns an empty config.
+func Load(path string) (*Config, error) {
+	cfg := &Config{}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return cfg, nil
+		}
+		return nil, fmt.Errorf("failed to read config file %s: %w", path, err)
+	}
+
+	if err := yaml.Unmarshal(data, cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse config file %s: %w", path, err)
+	}
+
+	if cfg.RefreshIntervalSeconds < 0 {
+		return nil, fmt.Errorf("invalid refresh_interval_seconds in %s: must be 0 or greater", path)
+	}
+	if cfg.ConcurrentRequests < 0 {
+		return nil, fmt.Errorf("invalid concurrent_requests in %s: must be 0 or greater", path)
+	}
+	if cfg.StaleRunMinutes < 0 {
+		return nil, fmt.Errorf("invalid stale_run_minutes in %s: must be 0 or greater", path)
+	}
+	if cfg.ListDensity != "" && !slices.Contains(ListDensities, cfg.ListDensity) {
+		return nil, fmt.Errorf("invalid list_density %q in %s: must be one of %s", cfg.ListDensity, path, strings.Join(ListDensities, ", "))
+	}
+	if err := cfg.Columns.validate(); err != nil {
+		return nil, fmt.Errorf("invalid columns in %s: %w", path, err)
+	}
+	if err := validateKeys(cfg.Keys); err != nil {
+		return nil, fmt.Errorf("invalid keys in %s: %w", path, err)
+	}
+
+	return cfg, nil
+}
+
+// LoadDefault reads the config file from the default path
+func LoadDefault() (*Config, error) {
+	path, err := DefaultPath()
+	if err != nil {
+		return nil, err
+	}
+	return Load(path)
+}
+
+// LoadKeyMap reads the key bindings of the config file at path.
+// Actions that are not configured keep their default bindings.
+func LoadKeyMap(path string) (KeyMap, error) {
+	cfg, err := Load(path)
+	if err != nil {
+		return DefaultKeyMap(), err
+	}
+	return cfg.KeyMap(), nil
+}
+
+// KeyMap returns the default key bindings overridden by the keys section of the config.
+// The keys are validated by Load.
+func (c *Config) KeyMap() KeyMap {
+	keyMap := DefaultKeyMap()
+	bindings := keyMap.bindingsByAction()
+	for action, keys := range c.Keys {
+		binding := bindings[action]
+		binding.SetKeys(keys...)
+		binding.SetHelp(strings.Join(keys, "/"), binding.Help().Desc)
+	}
+	return keyMap
+}
+
+// validateKeys checks that every action of the keys section is known and bound to valid key names
+// that are not reserved for the built-in commands
+func validateKeys(keys map[string]KeyList) error {
+	var keyMap KeyMap
+	bindings := keyMap.bindingsByAction()
+	for action, names := range keys {
+		if _, ok := bindings[action]; !ok {
+			return fmt.Errorf("unknown action %q: must be one of %s", action, strings.Join(keyMapActions(), ", "))
+		}
+		if len(names) == 0 {
+			return fmt.Errorf("no keys given for action %q", action)
+		}
+		for _, name := range names {
+			if !isValidKeyName(name) {
+				return fmt.Errorf("invalid key %q for action %q", name, action)
+			}
+			if isReservedKey(action, name) {
+				return fmt.Errorf("key %q for action %q is reserved for a built-in command (see ? in the TUI)", name, action)
+			}
+		}
+	}
+	return nil
+}
+
+// SaveListDensity stores the list density in the config file at path,
+// keeping the other settings and comments of the file
+func SaveListDensity(path, density string) error {
+	return setValue(path, "list_density", density)
+}
+
+// SaveDefaultListDensity stores the list density in the config file at the default path
+func SaveDefaultListDensity(density string) error {
+	path, err := DefaultPath()
+	if err != nil {
+		return err
+	}
+	return SaveListDensity(path, density)
+}
+
+// setValue sets a top-level key of the config file at path, creating the file if needed
+func setValue(path, key, value string) error {
+	var doc yaml.Node
+	data, err := os.ReadFile(path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to read config file %s: %w", path, err)
+	}
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return fmt.Errorf("failed to parse config file %s: %w", path, err)
+	}
+
+	// 空ファイルの場合はドキュメントを作成する
+	if doc.Kind == 0 {
+		doc = yaml.Node{Kind: yaml.DocumentNode, Content: []*yaml.Node{{Kind: yaml.MappingNode}}}
+	}
+	root := doc.Content[0]
+	if root.Kind != yaml.MappingNode {
+		return fmt.Errorf("failed to update config file %s: top level must be a mapping", path)
+	}
+
+	updated := false
+	for i := 0; i+1 < len(root.Content); i += 2 {
+		if root.Content[i].Value == key {
+			root.Content[i+1].SetString(value)
+			updated = true
+			break
+		}
+	}
+	if !updated {
+		keyNode := &yaml.Node{}
+		keyNode.SetString(key)
+		valueNode := &yaml.Node{}
+		valueNode.SetString(value)
+		root.Content = append(root.Content, keyNode, valueNode)
+	}
+
+	out, err := yaml.Marshal(&doc)
+	if err != nil {
+		return fmt.Errorf("failed to encode config file %s: %w", path, err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("failed to create config directory: %w", err)
+	}
+	if err := os.WriteFile(path, out, 0o644); err != nil {
+		return fmt.Errorf("failed to write config file %s: %w", path, err)
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// writeConfig writes content to a config file in a temporary directory and returns its path
+func writeConfig(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestConfigKeyMap(t *testing.T) {
+	cfg, err := Load(writeConfig(t, "keys:\n  up: K\n  down: [U, ctrl+n]\n  page_down: [ctrl+d, ctrl+f]\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyMap := cfg.KeyMap()
+
+	if !key.Matches(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("K")}, keyMap.Up) {
+		t.Error("up is not bound to K")
+	}
+	if key.Matches(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("k")}, keyMap.Up) {
+		t.Error("up is still bound to the default k")
+	}
+	if !key.Matches(tea.KeyMsg{Type: tea.KeyCtrlN}, keyMap.Down) {
+		t.Error("down is not bound to ctrl+n")
+	}
+	// 予約キーでもそのアクションのデフォルトの割り当てなら使える
+	if !key.Matches(tea.KeyMsg{Type: tea.KeyCtrlD}, keyMap.PageDown) {
+		t.Error("page_down is not bound to ctrl+d")
+	}
+	// 設定されていないアクションはデフォルトのまま
+	if !key.Matches(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("r")}, keyMap.Refresh) {
+		t.Error("refresh lost its default binding")
+	}
+}
+
+func TestLoadRejectsInvalidKeys(t *testing.T) {
+	tests := []struct {
+		content string
+		want    string
+	}{
+		{"keys:\n  jump: x\n", `unknown action "jump"`},
+		{"keys:\n  up: []\n", `no keys given for action "up"`},
+		{"keys:\n  up: ctrl+shift+k\n", `invalid key "ctrl+shift+k"`},
+		{"keys:\n  up: i\n", `key "i" for action "up" is reserved`},
+		{"keys:\n  down: ctrl+d\n", `key "ctrl+d" for action "down" is reserved`},
+	}
+	for _, tt := range tests {
+		_, err := Load(writeConfig(t, tt.content))
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("Load(%q) error = %v, want it to contain %q", tt.content, err, tt.want)
+		}
+	}
+}
@@ -0,0 +1,233 @@
+package config
+
+import (
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// KeyMap defines the key bindings for the TUI
+type KeyMap struct {
+	// Navigation
+	Up       key.Binding
+	Down     key.Binding
+	Left     key.Binding
+	Right    key.Binding
+	PageUp   key.Binding
+	PageDown key.Binding
+	Home     key.Binding
+	End      key.Binding
+
+	// Actions
+	Enter   key.Binding
+	Refresh key.Binding
+	Back    key.Binding
+
+	// Application
+	Quit key.Binding
+	Help key.Binding
+
+	// View switching
+	NextTab key.Binding
+	PrevTab key.Binding
+
+	// Pagination
+	NextPage key.Binding
+	PrevPage key.Binding
+
+	// Layout
+	TogglePreview key.Binding
+}
+
+// DefaultKeyMap returns a default key map
+func DefaultKeyMap() KeyMap {
+	return KeyMap{
+		// Navigation (Vim-style)
+		Up: key.NewBinding(
+			key.WithKeys("k", "up"),
+			key.WithHelp("k/↑", "move up"),
+		),
+		Down: key.NewBinding(
+			key.WithKeys("j", "down"),
+			key.WithHelp("j/↓", "move down"),
+		),
+		Left: key.NewBinding(
+			key.WithKeys("h", "left"),
+			key.WithHelp("h/←", "move left"),
+		),
+		Right: key.NewBinding(
+			key.WithKeys("l", "right"),
+			key.WithHelp("l/→", "move right"),
+		),
+		PageUp: key.NewBinding(
+			key.WithKeys("ctrl+u", "pgup"),
+			key.WithHelp("ctrl+u/pgup", "page up"),
+		),
+		PageDown: key.NewBinding(
+			key.WithKeys("ctrl+d", "pgdown"),
+			key.WithHelp("ctrl+d/pgdown", "page down"),
+		),
+		Home: key.NewBinding(
+			key.WithKeys("g", "home"),
+			key.WithHelp("g/home", "go to start"),
+		),
+		End: key.NewBinding(
+			key.WithKeys("G", "end"),
+			key.WithHelp("G/end", "go to end"),
+		),
+
+		// Actions
+		Enter: key.NewBinding(
+			// spaceはランの複数選択に使うので含めない
+			key.WithKeys("enter"),
+			key.WithHelp("enter", "select"),
+		),
+		Refresh: key.NewBinding(
+			key.WithKeys("r"),
+			key.WithHelp("r", "refresh"),
+		),
+		Back: key.NewBinding(
+			key.WithKeys("esc"),
+			key.WithHelp("esc", "back"),
+		),
+
+		// Application
+		Quit: key.NewBinding(
+			key.WithKeys("q", "ctrl+c"),
+			key.WithHelp("q/ctrl+c", "quit"),
+		),
+		Help: key.NewBinding(
+			key.WithKeys("?"),
+			key.WithHelp("?", "help"),
+		),
+
+		// View switching
+		NextTab: key.NewBinding(
+			key.WithKeys("tab"),
+			key.WithHelp("tab", "next tab"),
+		),
+		PrevTab: key.NewBinding(
+			key.WithKeys("shift+tab"),
+			key.WithHelp("shift+tab", "previous tab"),
+		),
+
+		// Pagination
+		NextPage: key.NewBinding(
+			key.WithKeys("n"),
+			key.WithHelp("n", "next page"),
+		),
+		PrevPage: key.NewBinding(
+			key.WithKeys("p"),
+			key.WithHelp("p", "previous page"),
+		),
+
+		// Layout
+		TogglePreview: key.NewBinding(
+			key.WithKeys("v"),
+			key.WithHelp("v", "show/hide preview panel"),
+		),
+	}
+}
+
+// ShortHelp returns keybindings to be shown in the mini help view
+func (k KeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{k.Help, k.Quit}
+}
+
+// FullHelp returns keybindings for the expanded help view
+func (k KeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.Up, k.Down, k.Left, k.Right},
+		{k.PageUp, k.PageDown, k.Home, k.End},
+		{k.Enter, k.Refresh, k.Back},
+		{k.NextTab, k.PrevTab},
+		{k.NextPage, k.PrevPage},
+		{k.TogglePreview},
+		{k.Help, k.Quit},
+	}
+}
+
+// bindingsByAction returns the bindings of the key map by their action name in the config file
+func (k *KeyMap) bindingsByAction() map[string]*key.Binding {
+	return map[string]*key.Binding{
+		"up":             &k.Up,
+		"down":           &k.Down,
+		"left":           &k.Left,
+		"right":          &k.Right,
+		"page_up":        &k.PageUp,
+		"page_down":      &k.PageDown,
+		"home":           &k.Home,
+		"end":            &k.End,
+		"enter":          &k.Enter,
+		"refresh":        &k.Refresh,
+		"back":           &k.Back,
+		"quit":           &k.Quit,
+		"help":           &k.Help,
+		"next_tab":       &k.NextTab,
+		"prev_tab":       &k.PrevTab,
+		"next_page":      &k.NextPage,
+		"prev_page":      &k.PrevPage,
+		"toggle_preview": &k.TogglePreview,
+	}
+}
+
+// keyMapActions returns the sorted action names accepted in the keys section of the config file
+func keyMapActions() []string {
+	var keyMap KeyMap
+	actions := make([]string, 0, len(keyMap.bindingsByAction()))
+	for action := range keyMap.bindingsByAction() {
+		actions = append(actions, action)
+	}
+	slices.Sort(actions)
+	return actions
+}
+
+// reservedKeys holds the keys the TUI handles itself before or instead of the key map
+// (e.g. i for the run metadata, S for the repository switcher), so binding them to an action would be shadowed
+var reservedKeys = map[string]bool{
+	"a": true, "b": true, "c": true, "d": true, "e": true, "f": true, "i": true, "l": true,
+	"n": true, "o": true, "r": true, "s": true, "w": true, "x": true, "y": true,
+	"A": true, "B": true, "C": true, "D": true, "E": true, "H": true, "I": true, "J": true,
+	"L": true, "N": true, "O": true, "P": true, "R": true, "S": true, "T": true, "X": true, "Y": true,
+	"/": true, ":": true, "+": true, "-": true, "!": true, "~": true, "@": true, " ": true,
+	"ctrl+d": true, "ctrl+s": true, "alt+c": true,
+}
+
+// isReservedKey reports whether name is handled by the TUI itself and is not a default key of action
+func isReservedKey(action, name string) bool {
+	if !reservedKeys[name] {
+		return false
+	}
+	// デフォルトの割り当て(例: page_downのctrl+d)はビューごとに使い分けているので許可する
+	defaults := DefaultKeyMap()
+	return !slices.Contains(defaults.bindingsByAction()[action].Keys(), name)
+}
+
+// namedKeys holds the names of special keys as reported by tea.KeyMsg.String (e.g. "up", "ctrl+c")
+var namedKeys = func() map[string]bool {
+	names := make(map[string]bool)
+	// KeyTypeは制御文字(0-127)と負の値の特殊キーからなる
+	for k := tea.KeyType(-128); k <= 127; k++ {
+		if name := k.String(); name != "" {
+			names[name] = true
+		}
+	}
+	// 文字入力を表す"runes"はキー名ではない
+	delete(names, tea.KeyRunes.String())
+	return names
+}()
+
+// isValidKeyName reports whether name is a key name that tea.KeyMsg.String can produce
+func isValidKeyName(name string) bool {
+	if rest, ok := strings.CutPrefix(name, "alt+"); ok && rest != "" {
+		name = rest
+	}
+	if namedKeys[name] {
+		return true
+	}
+	// Printable single characters such as "k" or "?"
+	return utf8.RuneCountInString(name) == 1
+}
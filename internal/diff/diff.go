@@ -0,0 +1,98 @@
+package diff
+
+// Op is the kind of a diff line
+type Op int
+
+const (
+	// Equal is a line present in both inputs
+	Equal Op = iota
+	// Insert is a line only present in the new input
+	Insert
+	// Delete is a line only present in the old input
+	Delete
+)
+
+// Line is a line of a line-by-line diff
+type Line struct {
+	Op   Op
+	Text string
+}
+
+// Lines computes the shortest line-by-line diff from a to b using the Myers algorithm
+func Lines(a, b []string) []Line {
+	n, m := len(a), len(b)
+	maxD := n + m
+	if maxD == 0 {
+		return nil
+	}
+
+	// v[k+offset] は対角線kで到達した最も遠いx
+	offset := maxD
+	v := make([]int, 2*maxD+2)
+	var trace [][]int
+
+	for d := 0; d <= maxD; d++ {
+		trace = append(trace, append([]int(nil), v...))
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[k-1+offset] < v[k+1+offset]) {
+				x = v[k+1+offset] // 下へ移動(挿入)
+			} else {
+				x = v[k-1+offset] + 1 // 右へ移動(削除)
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+			v[k+offset] = x
+			if x >= n && y >= m {
+				return backtrack(a, b, trace, d, offset)
+			}
+		}
+	}
+	return nil
+}
+
+// backtrack walks the recorded edit graph states back from the end to build the diff
+func backtrack(a, b []string, trace [][]int, d, offset int) []Line {
+	x, y := len(a), len(b)
+	var reversed []Line
+
+	for ; d > 0; d-- {
+		v := trace[d]
+		k := x - y
+		var prevK int
+		if k == -d || (k != d && v[k-1+offset] < v[k+1+offset]) {
+			prevK = k + 1
+		} else {
+			prevK = k - 1
+		}
+		prevX := v[prevK+offset]
+		prevY := prevX - prevK
+
+		for x > prevX && y > prevY {
+			x--
+			y--
+			reversed = append(reversed, Line{Op: Equal, Text: a[x]})
+		}
+		if x == prevX {
+			y--
+			reversed = append(reversed, Line{Op: Insert, Text: b[y]})
+		} else {
+			x--
+			reversed = append(reversed, Line{Op: Delete, Text: a[x]})
+		}
+	}
+	for x > 0 && y > 0 {
+		x--
+		y--
+		reversed = append(reversed, Line{Op: Equal, Text: a[x]})
+	}
+
+	lines := make([]Line, len(reversed))
+	for i, line := range reversed {
+		lines[len(reversed)-1-i] = line
+	}
+	return lines
+}
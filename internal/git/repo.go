@@ -11,6 +11,7 @@ import (
 
 // RepoInfo represents repository information
 type RepoInfo struct {
+	Host  string
 	Owner string
 	Repo  string
 }
@@ -118,44 +119,42 @@ func getRepoInfoFromConfig(gitDir string) (*RepoInfo, error) {
 	return nil, fmt.Errorf("no remote origin found in git config")
 }
 
-// parseRemoteURL parses a git remote URL to extract owner and repo
+// parseRemoteURL parses a git remote URL to extract host, owner and repo
 func parseRemoteURL(url string) (*RepoInfo, error) {
 	// Remove .git suffix if present
-	url = strings.TrimSuffix(url, ".git")
-
-	// Handle different URL formats
-	if strings.HasPrefix(url, "https://github.com/") {
-		// HTTPS format: https://github.com/owner/repo
-		path := strings.TrimPrefix(url, "https://github.com/")
-		parts := strings.Split(path, "/")
-		if len(parts) >= 2 {
-			return &RepoInfo{
-				Owner: parts[0],
-				Repo:  parts[1],
-			}, nil
+	trimmed := strings.TrimSuffix(strings.TrimSpace(url), ".git")
+
+	var host, path string
+	if idx := strings.Index(trimmed, "://"); idx >= 0 {
+		// URL format: https://host/owner/repo, ssh://git@host:22/owner/repo
+		rest := trimmed[idx+3:]
+		slash := strings.Index(rest, "/")
+		if slash < 0 {
+			return nil, fmt.Errorf("unsupported remote URL format: %s", url)
 		}
-	} else if strings.HasPrefix(url, "git@github.com:") {
-		// SSH format: git@github.com:owner/repo
-		path := strings.TrimPrefix(url, "git@github.com:")
-		parts := strings.Split(path, "/")
-		if len(parts) >= 2 {
-			return &RepoInfo{
-				Owner: parts[0],
-				Repo:  parts[1],
-			}, nil
+		host, path = rest[:slash], rest[slash+1:]
+		// Strip user info and port
+		if at := strings.LastIndex(host, "@"); at >= 0 {
+			host = host[at+1:]
 		}
-	} else if strings.Contains(url, "github.com") {
-		// Try to extract from any GitHub URL
-		parts := strings.Split(url, "/")
-		for i, part := range parts {
-			if part == "github.com" && i+2 < len(parts) {
-				return &RepoInfo{
-					Owner: parts[i+1],
-					Repo:  parts[i+2],
-				}, nil
-			}
+		if colon := strings.Index(host, ":"); colon >= 0 {
+			host = host[:colon]
 		}
+	} else if at := strings.Index(trimmed, "@"); at >= 0 && strings.Contains(trimmed[at:], ":") {
+		// SCP-like SSH format: git@host:owner/repo
+		rest := trimmed[at+1:]
+		colon := strings.Index(rest, ":")
+		host, path = rest[:colon], rest[colon+1:]
 	}
 
-	return nil, fmt.Errorf("unsupported remote URL format: %s", url)
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+	if host == "" || len(parts) < 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("unsupported remote URL format: %s", url)
+	}
+
+	return &RepoInfo{
+		Host:  host,
+		Owner: parts[0],
+		Repo:  parts[1],
+	}, nil
 }
@@ -6,14 +6,25 @@ import (
 	"context"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/cli/go-gh/v2/pkg/api"
+	"github.com/cli/go-gh/v2/pkg/auth"
 	"github.com/ryo246912/gh-actions-dash/internal/models"
+	"gopkg.in/yaml.v3"
 )
 
 // ErrorType represents different types of GitHub API errors
@@ -52,6 +63,12 @@ func categorizeError(err error) *GitHubError {
 
 	errorMsg := err.Error()
 
+	// 403でもセカンダリレート制限の場合は権限エラーではなく利用制限として扱う
+	var httpErr *api.HTTPError
+	if errors.As(err, &httpErr) && isRateLimitResponse(httpErr.StatusCode, httpErr.Headers, httpErr.Message) {
+		return rateLimitError(err)
+	}
+
 	// Check for authentication errors
 	if strings.Contains(errorMsg, "401") || strings.Contains(errorMsg, "authentication") ||
 		strings.Contains(errorMsg, "Bad credentials") || strings.Contains(errorMsg, "token") {
@@ -85,12 +102,7 @@ func categorizeError(err error) *GitHubError {
 
 	// Check for rate limit errors
 	if strings.Contains(errorMsg, "429") || strings.Contains(errorMsg, "rate limit") {
-		return &GitHubError{
-			Type:    ErrorTypeRateLimit,
-			Message: "API利用制限に達しました",
-			Details: "しばらく待ってから再試行してください",
-			Err:     err,
-		}
+		return rateLimitError(err)
 	}
 
 	// Check for network errors
@@ -113,6 +125,30 @@ func categorizeError(err error) *GitHubError {
 	}
 }
 
+// rateLimitError returns the error shown when the API rate limit is exceeded
+func rateLimitError(err error) *GitHubError {
+	return &GitHubError{
+		Type:    ErrorTypeRateLimit,
+		Message: "API利用制限に達しました",
+		Details: "しばらく待ってから再試行してください",
+		Err:     err,
+	}
+}
+
+// isRateLimitResponse reports whether a 403 or 429 response is caused by the (secondary) rate limit
+// rather than by missing permissions: GitHub sets Retry-After, exhausts X-RateLimit-Remaining
+// or mentions the rate limit in the message.
+func isRateLimitResponse(statusCode int, header http.Header, message string) bool {
+	if statusCode != http.StatusForbidden && statusCode != http.StatusTooManyRequests {
+		return false
+	}
+	if header.Get("Retry-After") != "" || header.Get("X-RateLimit-Remaining") == "0" {
+		return true
+	}
+	message = strings.ToLower(message)
+	return strings.Contains(message, "secondary rate limit") || strings.Contains(message, "rate limit exceeded")
+}
+
 // RetryConfig defines retry configuration
 type RetryConfig struct {
 	MaxRetries   int
@@ -129,6 +165,66 @@ func DefaultRetryConfig() RetryConfig {
 	}
 }
 
+// Environment variables overriding the default retry configuration
+const (
+	MaxRetriesEnv     = "GH_ACTIONS_DASH_MAX_RETRIES"
+	InitialDelayMsEnv = "GH_ACTIONS_DASH_INITIAL_DELAY_MS"
+	MaxDelayMsEnv     = "GH_ACTIONS_DASH_MAX_DELAY_MS"
+)
+
+// Upper bounds of the retry environment variables
+const (
+	maxRetriesLimit = 10
+	maxDelayMsLimit = 10 * 60 * 1000 // 10 minutes
+)
+
+// retryConfigFromEnv returns the default retry configuration overridden by the retry environment variables.
+// Variables that are unset or not numeric keep their default value, and out-of-range values are rejected.
+func retryConfigFromEnv() (RetryConfig, error) {
+	config := DefaultRetryConfig()
+
+	maxRetries, err := intFromEnv(MaxRetriesEnv, config.MaxRetries, maxRetriesLimit)
+	if err != nil {
+		return config, err
+	}
+	initialDelayMs, err := intFromEnv(InitialDelayMsEnv, int(config.InitialDelay/time.Millisecond), maxDelayMsLimit)
+	if err != nil {
+		return config, err
+	}
+	maxDelayMs, err := intFromEnv(MaxDelayMsEnv, int(config.MaxDelay/time.Millisecond), maxDelayMsLimit)
+	if err != nil {
+		return config, err
+	}
+	// 0だとリトライの待ち時間が常に0になる
+	if maxDelayMs == 0 {
+		return config, fmt.Errorf("invalid %s value 0: must be greater than 0", MaxDelayMsEnv)
+	}
+	if maxDelayMs < initialDelayMs {
+		return config, fmt.Errorf("invalid %s value %d: must not be less than %s (%d)", MaxDelayMsEnv, maxDelayMs, InitialDelayMsEnv, initialDelayMs)
+	}
+
+	config.MaxRetries = maxRetries
+	config.InitialDelay = time.Duration(initialDelayMs) * time.Millisecond
+	config.MaxDelay = time.Duration(maxDelayMs) * time.Millisecond
+	return config, nil
+}
+
+// intFromEnv parses an integer environment variable between 0 and limit, returning def when it is unset or not numeric
+func intFromEnv(name string, def, limit int) (int, error) {
+	value, ok := os.LookupEnv(name)
+	if !ok {
+		return def, nil
+	}
+	n, err := strconv.Atoi(strings.TrimSpace(value))
+	if err != nil {
+		return def, nil
+	}
+	if n < 0 || n > limit {
+		return def, fmt.Errorf("invalid %s value %d: must be between 0 and %d", name, n, limit)
+	}
+	return n, nil
+}
+
 // isRetryableError checks if an error is retryable
 func isRetryableError(err error) bool {
 	if err == nil {
@@ -185,9 +281,10 @@ func retryWithBackoff(config RetryConfig, operation func() error) error {
 		}
 
 		// Calculate delay with exponential backoff
-		delay := config.InitialDelay * time.Duration(1<<attempt)
-		if delay > config.MaxDelay {
-			delay = config.MaxDelay
+		// シフト後がMaxDelayを超える場合は掛け算する前に打ち切ってオーバーフローを防ぐ
+		delay := config.MaxDelay
+		if config.InitialDelay <= config.MaxDelay>>attempt {
+			delay = config.InitialDelay << attempt
 		}
 
 		time.Sleep(delay)
@@ -196,32 +293,162 @@ func retryWithBackoff(config RetryConfig, operation func() error) error {
 	return lastErr
 }
 
+// DefaultConcurrentRequests is the default number of API requests that may run at the same time
+const DefaultConcurrentRequests = 3
+
 // Client wraps GitHub API client
 type Client struct {
 	restClient  api.RESTClient
 	retryConfig RetryConfig
+	requests    chan struct{} // semaphore limiting the concurrent API requests
+	host        string
+	transport   *rateLimitTransport
+	scopes      *scopesTransport
+	authToken   string // token resolved for host by go-gh
+	authSource  string // where authToken comes from (an environment variable name, "oauth_token", "keyring" or "gh")
+	debug       *debugTransport
+
+	// Default branch per "owner/repo", looked up once per session
+	defaultBranchMu sync.Mutex
+	defaultBranches map[string]string
+
+	// Pull requests per "owner/repo#number", looked up once per session for their head
+	pullRequestsMu sync.Mutex
+	pullRequests   map[string]models.PullRequest
 }
 
-// NewClient creates a new GitHub API client
+// NewClient creates a new GitHub API client for the default host
 func NewClient() (*Client, error) {
-	restClient, err := api.DefaultRESTClient()
+	return NewClientWithHostname("")
+}
+
+// NewClientWithHostname creates a new GitHub API client for the given host
+// (e.g. a GitHub Enterprise Server hostname). An empty host uses the default host.
+func NewClientWithHostname(host string) (*Client, error) {
+	if host == "" {
+		host, _ = auth.DefaultHost()
+	}
+	host = auth.NormalizeHostname(host)
+
+	// go-ghと同じ優先順位(GH_TOKEN, GITHUB_TOKEN, ghの認証情報)でホストごとのトークンを使う
+	authToken, authSource := auth.TokenForHost(host)
+
+	retryConfig, err := retryConfigFromEnv()
+	if err != nil {
+		return nil, err
+	}
+
+	transport := newRateLimitTransport()
+	scopes := newScopesTransport(transport)
+	restClient, err := api.NewRESTClient(api.ClientOptions{Host: host, AuthToken: authToken, Transport: scopes})
 	if err != nil {
 		return nil, categorizeError(err)
 	}
 
 	return &Client{
 		restClient:  *restClient,
-		retryConfig: DefaultRetryConfig(),
+		retryConfig: retryConfig,
+		requests:    make(chan struct{}, DefaultConcurrentRequests),
+		host:        host,
+		transport:   transport,
+		scopes:      scopes,
+		authToken:   authToken,
+		authSource:  authSource,
 	}, nil
 }
 
+// SetConcurrentRequests sets how many API requests may run at the same time.
+// It must be called before the client is used.
+func (c *Client) SetConcurrentRequests(n int) {
+	c.requests = make(chan struct{}, max(n, 1))
+}
+
+// retry runs an API request with retryWithBackoff once a slot of the request semaphore is free,
+// so that bursts of parallel requests are not throttled by GitHub
+func (c *Client) retry(operation func() error) error {
+	// スロットは試行ごとに確保し、バックオフ中は他のリクエストに譲る
+	return retryWithBackoff(c.retryConfig, func() error {
+		return c.limit(operation)
+	})
+}
+
+// limit runs a single request while holding one of the concurrent request slots.
+// Requests that must not be retried (e.g. POST actions) use it directly.
+func (c *Client) limit(operation func() error) error {
+	c.requests <- struct{}{}
+	defer func() { <-c.requests }()
+	return operation()
+}
+
+// doRequest sends req while holding a request slot and returns the response with its body read
+func (c *Client) doRequest(httpClient *http.Client, req *http.Request) (*http.Response, []byte, error) {
+	var resp *http.Response
+	var body []byte
+	err := c.limit(func() error {
+		var err error
+		if resp, err = httpClient.Do(req); err != nil {
+			return err
+		}
+		defer func() { _ = resp.Body.Close() }()
+		body, err = io.ReadAll(resp.Body)
+		return err
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return resp, body, nil
+}
+
+// AuthMethod describes where the client's credentials come from
+func (c *Client) AuthMethod() string {
+	switch {
+	case c.authToken == "":
+		return "no credentials (run gh auth login or set GH_TOKEN)"
+	case strings.HasSuffix(c.authSource, "_TOKEN"):
+		return c.authSource + " environment variable"
+	default:
+		return "gh CLI credentials"
+	}
+}
+
+// RateLimit returns the rate-limit usage reported by the most recent API response.
+// ok is false until a response with rate-limit headers has been received.
+func (c *Client) RateLimit() (rateLimit RateLimit, ok bool) {
+	return c.transport.current()
+}
+
+// Host returns the GitHub host the client is connected to
+func (c *Client) Host() string {
+	return c.host
+}
+
+// apiURL returns the full REST API URL for an endpoint on the configured host
+func (c *Client) apiURL(endpoint string) string {
+	if auth.IsEnterprise(c.host) {
+		return fmt.Sprintf("https://%s/api/v3/%s", c.host, endpoint)
+	}
+	return "https://api.github.com/" + endpoint
+}
+
+// webURL returns the web URL for a path on the configured host
+func (c *Client) webURL(path string) string {
+	return fmt.Sprintf("https://%s/%s", c.host, path)
+}
+
+// httpClient returns an authenticated HTTP client for the configured host
+func (c *Client) httpClient() (*http.Client, error) {
+	return api.NewHTTPClient(api.ClientOptions{Host: c.host, AuthToken: c.authToken, Transport: c.transport})
+}
+
 // GetCurrentUser returns the current authenticated user
 func (c *Client) GetCurrentUser() (string, error) {
 	response := struct {
 		Login string `json:"login"`
 	}{}
 
-	err := c.restClient.Get("user", &response)
+	err := c.limit(func() error {
+		return c.restClient.Get("user", &response)
+	})
 	if err != nil {
 		return "", categorizeError(err)
 	}
@@ -232,7 +459,9 @@ func (c *Client) GetCurrentUser() (string, error) {
 // GetRepository returns repository information
 func (c *Client) GetRepository(owner, repo string) (*models.Repository, error) {
 	var repository models.Repository
-	err := c.restClient.Get(fmt.Sprintf("repos/%s/%s", owner, repo), &repository)
+	err := c.limit(func() error {
+		return c.restClient.Get(fmt.Sprintf("repos/%s/%s", owner, repo), &repository)
+	})
 	if err != nil {
 		return nil, categorizeError(err)
 	}
@@ -240,13 +469,37 @@ func (c *Client) GetRepository(owner, repo string) (*models.Repository, error) {
 	return &repository, nil
 }
 
+// GetDefaultBranch returns the default branch of a repository, cached for the session
+func (c *Client) GetDefaultBranch(owner, repo string) (string, error) {
+	key := owner + "/" + repo
+	c.defaultBranchMu.Lock()
+	branch, ok := c.defaultBranches[key]
+	c.defaultBranchMu.Unlock()
+	if ok {
+		return branch, nil
+	}
+
+	repository, err := c.GetRepository(owner, repo)
+	if err != nil {
+		return "", err
+	}
+
+	c.defaultBranchMu.Lock()
+	defer c.defaultBranchMu.Unlock()
+	if c.defaultBranches == nil {
+		c.defaultBranches = make(map[string]string)
+	}
+	c.defaultBranches[key] = repository.DefaultBranch
+	return repository.DefaultBranch, nil
+}
+
 // GetWorkflows returns all workflows for a repository
 func (c *Client) GetWorkflows(owner, repo string) ([]models.Workflow, error) {
 	response := struct {
 		Workflows []models.Workflow `json:"workflows"`
 	}{}
 
-	err := retryWithBackoff(c.retryConfig, func() error {
+	err := c.retry(func() error {
 		return c.restClient.Get(fmt.Sprintf("repos/%s/%s/actions/workflows", owner, repo), &response)
 	})
 
@@ -266,7 +519,7 @@ func (c *Client) GetWorkflowsPaginated(owner, repo string, page, perPage int) ([
 
 	endpoint := fmt.Sprintf("repos/%s/%s/actions/workflows?page=%d&per_page=%d", owner, repo, page, perPage)
 
-	err := retryWithBackoff(c.retryConfig, func() error {
+	err := c.retry(func() error {
 		return c.restClient.Get(endpoint, &response)
 	})
 
@@ -277,31 +530,100 @@ func (c *Client) GetWorkflowsPaginated(owner, repo string, page, perPage int) ([
 	return response.Workflows, response.TotalCount, nil
 }
 
-// GetWorkflowRuns returns workflow runs for a workflow
-func (c *Client) GetWorkflowRuns(owner, repo string, workflowID int64) ([]models.WorkflowRun, error) {
+// GetWorkflowRunsPaginated returns workflow runs for a workflow with pagination support,
+// limited to runs matching the filter
+func (c *Client) GetWorkflowRunsPaginated(owner, repo string, workflowID int64, page, perPage int, filter RunFilter) ([]models.WorkflowRun, int, error) {
 	response := struct {
 		WorkflowRuns []models.WorkflowRun `json:"workflow_runs"`
+		TotalCount   int                  `json:"total_count"`
 	}{}
 
-	err := retryWithBackoff(c.retryConfig, func() error {
-		return c.restClient.Get(fmt.Sprintf("repos/%s/%s/actions/workflows/%d/runs", owner, repo, workflowID), &response)
+	endpoint := fmt.Sprintf("repos/%s/%s/actions/workflows/%d/runs?page=%d&per_page=%d", owner, repo, workflowID, page, perPage)
+	if query := filter.query(); len(query) > 0 {
+		endpoint += "&" + query.Encode()
+	}
+
+	err := c.retry(func() error {
+		return c.restClient.Get(endpoint, &response)
+	})
+
+	if err != nil {
+		return nil, 0, categorizeError(err)
+	}
+
+	return response.WorkflowRuns, response.TotalCount, nil
+}
+
+// GetPendingDeployments returns the deployments of a workflow run waiting for approval
+func (c *Client) GetPendingDeployments(owner, repo string, runID int64) ([]models.PendingDeployment, error) {
+	var deployments []models.PendingDeployment
+
+	err := c.retry(func() error {
+		return c.restClient.Get(fmt.Sprintf("repos/%s/%s/actions/runs/%d/pending_deployments", owner, repo, runID), &deployments)
 	})
 
 	if err != nil {
 		return nil, categorizeError(err)
 	}
 
-	return response.WorkflowRuns, nil
+	return deployments, nil
+}
+
+// ApproveDeployment approves the pending deployments of a workflow run for the given environments
+func (c *Client) ApproveDeployment(owner, repo string, runID int64, environmentIDs []int64, comment string) error {
+	return c.reviewPendingDeployments(owner, repo, runID, environmentIDs, "approved", comment)
+}
+
+// RejectDeployment rejects the pending deployments of a workflow run for the given environments
+func (c *Client) RejectDeployment(owner, repo string, runID int64, environmentIDs []int64, comment string) error {
+	return c.reviewPendingDeployments(owner, repo, runID, environmentIDs, "rejected", comment)
 }
 
-// GetWorkflowRunJobs returns jobs for a workflow run
-func (c *Client) GetWorkflowRunJobs(owner, repo string, runID int64) ([]models.Job, error) {
+// reviewPendingDeployments approves or rejects pending deployments
+func (c *Client) reviewPendingDeployments(owner, repo string, runID int64, environmentIDs []int64, state, comment string) error {
+	payload := struct {
+		EnvironmentIDs []int64 `json:"environment_ids"`
+		State          string  `json:"state"`
+		Comment        string  `json:"comment"`
+	}{
+		EnvironmentIDs: environmentIDs,
+		State:          state,
+		Comment:        comment,
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to encode review request: %w", err)
+	}
+
+	var deployments []json.RawMessage
+	err = c.limit(func() error {
+		return c.restClient.Post(fmt.Sprintf("repos/%s/%s/actions/runs/%d/pending_deployments", owner, repo, runID), bytes.NewReader(body), &deployments)
+	})
+	if err != nil {
+		return categorizeError(err)
+	}
+
+	return nil
+}
+
+// Filters of the jobs of a workflow run
+const (
+	// JobsFilterLatest returns only the jobs of the latest attempt
+	JobsFilterLatest = "latest"
+	// JobsFilterAll returns the jobs of every attempt
+	JobsFilterAll = "all"
+)
+
+// GetWorkflowRunJobs returns jobs for a workflow run.
+// filter is JobsFilterLatest or JobsFilterAll (the jobs of every attempt of a re-run).
+func (c *Client) GetWorkflowRunJobs(owner, repo string, runID int64, filter string) ([]models.Job, error) {
 	response := struct {
 		Jobs []models.Job `json:"jobs"`
 	}{}
 
-	err := retryWithBackoff(c.retryConfig, func() error {
-		return c.restClient.Get(fmt.Sprintf("repos/%s/%s/actions/runs/%d/jobs", owner, repo, runID), &response)
+	err := c.retry(func() error {
+		return c.restClient.Get(fmt.Sprintf("repos/%s/%s/actions/runs/%d/jobs?filter=%s", owner, repo, runID, url.QueryEscape(filter)), &response)
 	})
 
 	if err != nil {
@@ -311,13 +633,40 @@ func (c *Client) GetWorkflowRunJobs(owner, repo string, runID int64) ([]models.J
 	return response.Jobs, nil
 }
 
+// GetAllAttemptJobs returns the jobs of every attempt of a workflow run
+func (c *Client) GetAllAttemptJobs(owner, repo string, runID int64) ([]models.Job, error) {
+	return c.GetWorkflowRunJobs(owner, repo, runID, JobsFilterAll)
+}
+
+// GetJobLogs returns the plain-text log of a single job
+func (c *Client) GetJobLogs(owner, repo string, jobID int64) (string, error) {
+	var content []byte
+	err := c.retry(func() error {
+		// リダイレクト先のテキストファイルまで追従する
+		resp, err := c.restClient.Request(http.MethodGet, fmt.Sprintf("repos/%s/%s/actions/jobs/%d/logs", owner, repo, jobID), nil)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = resp.Body.Close() }()
+
+		content, err = io.ReadAll(resp.Body)
+		return err
+	})
+
+	if err != nil {
+		return "", categorizeError(err)
+	}
+
+	return string(content), nil
+}
+
 // GetAllWorkflowRuns returns all workflow runs for a repository (across all workflows)
 func (c *Client) GetAllWorkflowRuns(owner, repo string) ([]models.WorkflowRun, error) {
 	response := struct {
 		WorkflowRuns []models.WorkflowRun `json:"workflow_runs"`
 	}{}
 
-	err := retryWithBackoff(c.retryConfig, func() error {
+	err := c.retry(func() error {
 		return c.restClient.Get(fmt.Sprintf("repos/%s/%s/actions/runs", owner, repo), &response)
 	})
 
@@ -328,16 +677,78 @@ func (c *Client) GetAllWorkflowRuns(owner, repo string) ([]models.WorkflowRun, e
 	return response.WorkflowRuns, nil
 }
 
-// GetAllWorkflowRunsPaginated returns workflow runs for a repository with pagination support
-func (c *Client) GetAllWorkflowRunsPaginated(owner, repo string, page, perPage int) ([]models.WorkflowRun, int, error) {
+// TimeRange represents an optional creation time range of workflow runs.
+// A zero Since or Until leaves that side of the range open.
+type TimeRange struct {
+	Since time.Time
+	Until time.Time
+}
+
+// IsZero reports whether the range has no bounds
+func (r TimeRange) IsZero() bool {
+	return r.Since.IsZero() && r.Until.IsZero()
+}
+
+// query returns the value of the "created" query parameter for the range
+func (r TimeRange) query() string {
+	const layout = "2006-01-02T15:04:05Z"
+	switch {
+	case r.IsZero():
+		return ""
+	case r.Until.IsZero():
+		return ">=" + r.Since.UTC().Format(layout)
+	case r.Since.IsZero():
+		return "<=" + r.Until.UTC().Format(layout)
+	default:
+		return r.Since.UTC().Format(layout) + ".." + r.Until.UTC().Format(layout)
+	}
+}
+
+// RunFilter narrows down the workflow runs of a repository.
+// Zero-valued fields are not sent to the API.
+type RunFilter struct {
+	Branch  string
+	Event   string
+	Status  string
+	Actor   string
+	Created TimeRange
+}
+
+// query returns the filter as query parameters
+func (f RunFilter) query() url.Values {
+	values := url.Values{}
+	if f.Branch != "" {
+		values.Set("branch", f.Branch)
+	}
+	if f.Event != "" {
+		values.Set("event", f.Event)
+	}
+	if f.Status != "" {
+		values.Set("status", f.Status)
+	}
+	if f.Actor != "" {
+		values.Set("actor", f.Actor)
+	}
+	if !f.Created.IsZero() {
+		values.Set("created", f.Created.query())
+	}
+	return values
+}
+
+// GetAllWorkflowRunsPaginated returns workflow runs for a repository with pagination support,
+// limited to runs matching the filter
+func (c *Client) GetAllWorkflowRunsPaginated(owner, repo string, page, perPage int, filter RunFilter) ([]models.WorkflowRun, int, error) {
 	response := struct {
 		WorkflowRuns []models.WorkflowRun `json:"workflow_runs"`
 		TotalCount   int                  `json:"total_count"`
 	}{}
 
 	endpoint := fmt.Sprintf("repos/%s/%s/actions/runs?page=%d&per_page=%d", owner, repo, page, perPage)
+	if query := filter.query(); len(query) > 0 {
+		endpoint += "&" + query.Encode()
+	}
 
-	err := retryWithBackoff(c.retryConfig, func() error {
+	err := c.retry(func() error {
 		return c.restClient.Get(endpoint, &response)
 	})
 
@@ -348,49 +759,116 @@ func (c *Client) GetAllWorkflowRunsPaginated(owner, repo string, page, perPage i
 	return response.WorkflowRuns, response.TotalCount, nil
 }
 
+// GetPullRequest returns a pull request, cached for the session since only its head is used
+func (c *Client) GetPullRequest(owner, repo string, number int) (*models.PullRequest, error) {
+	key := fmt.Sprintf("%s/%s#%d", owner, repo, number)
+	c.pullRequestsMu.Lock()
+	cached, ok := c.pullRequests[key]
+	c.pullRequestsMu.Unlock()
+	if ok {
+		return &cached, nil
+	}
+
+	var pullRequest models.PullRequest
+	err := c.retry(func() error {
+		return c.restClient.Get(fmt.Sprintf("repos/%s/%s/pulls/%d", owner, repo, number), &pullRequest)
+	})
+	if err != nil {
+		return nil, categorizeError(err)
+	}
+
+	c.pullRequestsMu.Lock()
+	defer c.pullRequestsMu.Unlock()
+	if c.pullRequests == nil {
+		c.pullRequests = make(map[string]models.PullRequest)
+	}
+	c.pullRequests[key] = pullRequest
+	return &pullRequest, nil
+}
+
+// GetWorkflowRunsForPR returns the runs triggered by a pull request with pagination support.
+// The API cannot filter by PR number, so the pull_request runs are queried by the head branch of the PR
+// and the runs of other PRs using the same branch name (e.g. from forks) are dropped from each page.
+// The total count is the one of the branch query.
+func (c *Client) GetWorkflowRunsForPR(owner, repo string, prNumber int, page, perPage int) ([]models.WorkflowRun, int, error) {
+	pullRequest, err := c.GetPullRequest(owner, repo, prNumber)
+	if err != nil {
+		return nil, 0, err
+	}
+	runs, total, err := c.GetAllWorkflowRunsPaginated(owner, repo, page, perPage, RunFilter{Branch: pullRequest.Head.Ref, Event: "pull_request"})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	filtered := runs[:0]
+	for _, run := range runs {
+		if runBelongsToPR(run, pullRequest) {
+			filtered = append(filtered, run)
+		}
+	}
+	return filtered, total, nil
+}
+
+// runBelongsToPR reports whether a run lists the pull request or was built from the PR's head repository.
+// Runs of fork PRs do not list their pull requests, so the head repository is compared as well.
+func runBelongsToPR(run models.WorkflowRun, pullRequest *models.PullRequest) bool {
+	for _, pr := range run.PullRequests {
+		if pr.Number == pullRequest.Number {
+			return true
+		}
+	}
+	headRepo := pullRequest.Head.Repo
+	return headRepo != nil && headRepo.FullName != "" && strings.EqualFold(run.HeadRepository.FullName, headRepo.FullName)
+}
+
+// ErrLogsRestricted is returned when log downloads are forbidden, e.g. by an organization policy
+var ErrLogsRestricted = errors.New("log access is restricted by your organization's policy")
+
 // GetWorkflowRunLogs returns logs for a workflow run
-func (c *Client) GetWorkflowRunLogs(owner, repo string, runID int64) (string, error) {
+func (c *Client) GetWorkflowRunLogs(owner, repo string, runID int64) (*models.RunLogs, error) {
 	// Try to get actual logs from GitHub API
 	actualLogs, err := c.downloadWorkflowRunLogs(owner, repo, runID)
 	if err != nil {
 		// Fallback to job/step information if log download fails
 		fallbackLogs, fallbackErr := c.getJobStepInfo(owner, repo, runID)
 		if fallbackErr != nil {
-			return "", categorizeError(fallbackErr)
+			return nil, categorizeError(fallbackErr)
 		}
 
 		// Add notice about log download failure
 		var content strings.Builder
-		content.WriteString("⚠️  ログダウンロードに失敗しました。ジョブ・ステップ情報を表示します。\n")
-		content.WriteString("📋 エラー詳細: ")
-		content.WriteString(err.Error())
-		content.WriteString("\n\n")
-		content.WriteString("💡 実際のログを確認するには、GitHub Web UIをご利用ください。\n")
-		content.WriteString("🔗 https://github.com/")
-		content.WriteString(owner)
-		content.WriteString("/")
-		content.WriteString(repo)
-		content.WriteString("/actions/runs/")
-		content.WriteString(fmt.Sprintf("%d", runID))
+		if errors.Is(err, ErrLogsRestricted) {
+			// 組織のポリシーでログが制限されている場合はブラウザでの確認を案内する
+			content.WriteString("🔒 Log access is restricted by your organization's policy. Visit the run URL in your browser to view logs.\n")
+			content.WriteString("💡 Press o to open the run in your browser.\n")
+		} else {
+			content.WriteString("⚠️  ログダウンロードに失敗しました。ジョブ・ステップ情報を表示します。\n")
+			content.WriteString("📋 エラー詳細: ")
+			content.WriteString(err.Error())
+			content.WriteString("\n\n")
+			content.WriteString("💡 実際のログを確認するには、GitHub Web UIをご利用ください。\n")
+		}
+		content.WriteString("🔗 ")
+		content.WriteString(c.webURL(fmt.Sprintf("%s/%s/actions/runs/%d", owner, repo, runID)))
 		content.WriteString("\n\n")
 		content.WriteString("=" + strings.Repeat("=", 60) + "\n\n")
 		content.WriteString(fallbackLogs)
 
-		return content.String(), nil
+		return &models.RunLogs{Content: content.String()}, nil
 	}
 
 	return actualLogs, nil
 }
 
 // downloadWorkflowRunLogs downloads and extracts the actual logs from GitHub API
-func (c *Client) downloadWorkflowRunLogs(owner, repo string, runID int64) (string, error) {
+func (c *Client) downloadWorkflowRunLogs(owner, repo string, runID int64) (*models.RunLogs, error) {
 	// The GitHub API endpoint for workflow run logs
 	endpoint := fmt.Sprintf("repos/%s/%s/actions/runs/%d/logs", owner, repo, runID)
 
 	// Use gh CLI's HTTP client for authentication
-	httpClient, err := api.DefaultHTTPClient()
+	httpClient, err := c.httpClient()
 	if err != nil {
-		return "", fmt.Errorf("failed to create HTTP client: %w", err)
+		return nil, fmt.Errorf("failed to create HTTP client: %w", err)
 	}
 
 	// Create HTTP client that doesn't follow redirects
@@ -399,65 +877,99 @@ func (c *Client) downloadWorkflowRunLogs(owner, repo string, runID int64) (strin
 	}
 
 	// Make a request to get the redirect URL
-	req, err := http.NewRequest("GET", fmt.Sprintf("https://api.github.com/%s", endpoint), nil)
+	req, err := http.NewRequest("GET", c.apiURL(endpoint), nil)
 	if err != nil {
-		return "", fmt.Errorf("failed to create request: %w", err)
+		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
 	req.Header.Set("Accept", "application/vnd.github.v3+json")
 
-	resp, err := httpClient.Do(req)
+	resp, body, err := c.doRequest(httpClient, req)
 	if err != nil {
-		return "", fmt.Errorf("failed to make request: %w", err)
+		return nil, fmt.Errorf("failed to make request: %w", err)
 	}
-	defer func() {
-		_ = resp.Body.Close()
-	}()
 
+	if isRateLimitResponse(resp.StatusCode, resp.Header, string(body)) {
+		return nil, rateLimitError(fmt.Errorf("status %d: %s", resp.StatusCode, body))
+	}
+	if resp.StatusCode == http.StatusForbidden {
+		return nil, ErrLogsRestricted
+	}
 	if resp.StatusCode != http.StatusFound {
-		return "", fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
 	}
 
 	// Get the redirect URL
 	location := resp.Header.Get("Location")
 	if location == "" {
-		return "", fmt.Errorf("no redirect location found")
+		return nil, fmt.Errorf("no redirect location found")
 	}
 
-	// Download the ZIP file
-	zipResp, err := http.Get(location)
+	// Download the ZIP file into memory
+	zipReq, err := http.NewRequest("GET", location, nil)
 	if err != nil {
-		return "", fmt.Errorf("failed to download logs: %w", err)
+		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
-	defer func() {
-		_ = zipResp.Body.Close()
-	}()
-
-	if zipResp.StatusCode != http.StatusOK {
-		return "", fmt.Errorf("failed to download logs: status %d", zipResp.StatusCode)
+	// ストレージのURLは署名付きなのでトークンを付けないクライアントで取得する
+	zipResp, zipData, err := c.doRequest(&http.Client{Transport: c.transport}, zipReq)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download logs: %w", err)
 	}
 
-	// Read the ZIP file into memory
-	zipData, err := io.ReadAll(zipResp.Body)
-	if err != nil {
-		return "", fmt.Errorf("failed to read zip data: %w", err)
+	if zipResp.StatusCode == http.StatusForbidden {
+		return nil, ErrLogsRestricted
+	}
+	if zipResp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to download logs: status %d", zipResp.StatusCode)
 	}
 
 	// Extract and parse the ZIP file
 	return c.extractLogsFromZip(zipData)
 }
 
-// extractLogsFromZip extracts log contents from the ZIP file
-func (c *Client) extractLogsFromZip(zipData []byte) (string, error) {
+// logStepNumber matches the step number prefix of a log file name (e.g. "10_Run tests.txt")
+var logStepNumber = regexp.MustCompile(`^(\d+)_`)
+
+// sortLogFiles orders the log files by job directory and then numerically by step number,
+// so that "10_Run tests.txt" comes after "2_Checkout.txt"
+func sortLogFiles(files []*zip.File) []*zip.File {
+	stepNumber := func(name string) int {
+		match := logStepNumber.FindStringSubmatch(path.Base(name))
+		if match == nil {
+			return -1
+		}
+		n, _ := strconv.Atoi(match[1])
+		return n
+	}
+
+	sorted := append([]*zip.File(nil), files...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		dirI, dirJ := path.Dir(sorted[i].Name), path.Dir(sorted[j].Name)
+		if dirI != dirJ {
+			return dirI < dirJ
+		}
+		stepI, stepJ := stepNumber(sorted[i].Name), stepNumber(sorted[j].Name)
+		if stepI != stepJ {
+			return stepI < stepJ
+		}
+		return sorted[i].Name < sorted[j].Name
+	})
+	return sorted
+}
+
+// extractLogsFromZip extracts log contents from the ZIP file, recording which lines came from which file
+func (c *Client) extractLogsFromZip(zipData []byte) (*models.RunLogs, error) {
 	reader, err := zip.NewReader(bytes.NewReader(zipData), int64(len(zipData)))
 	if err != nil {
-		return "", fmt.Errorf("failed to create zip reader: %w", err)
+		return nil, fmt.Errorf("failed to create zip reader: %w", err)
 	}
 
 	var logContent strings.Builder
+	var sections []models.LogSection
+	lineCount := 0
 
-	// Process each file in the ZIP
-	for _, file := range reader.File {
+	// Process each file in the ZIP in step order
+	for _, file := range sortLogFiles(reader.File) {
 		if file.FileInfo().IsDir() {
 			continue
 		}
@@ -476,17 +988,25 @@ func (c *Client) extractLogsFromZip(zipData []byte) (string, error) {
 		}
 
 		// Add file header and content
-		logContent.WriteString(fmt.Sprintf("=== %s ===\n", file.Name))
-		logContent.WriteString(string(content))
-		logContent.WriteString("\n\n")
+		entry := fmt.Sprintf("=== %s ===\n", file.Name) + string(content) + "\n\n"
+		logContent.WriteString(entry)
+
+		// Tag the lines of this entry with its source file name
+		entryLines := strings.Count(entry, "\n")
+		sections = append(sections, models.LogSection{
+			FileName:  file.Name,
+			StartLine: lineCount,
+			EndLine:   lineCount + entryLines,
+		})
+		lineCount += entryLines
 	}
 
-	return logContent.String(), nil
+	return &models.RunLogs{Content: logContent.String(), Sections: sections}, nil
 }
 
 // getJobStepInfo is the fallback method that returns job/step information
 func (c *Client) getJobStepInfo(owner, repo string, runID int64) (string, error) {
-	jobs, err := c.GetWorkflowRunJobs(owner, repo, runID)
+	jobs, err := c.GetWorkflowRunJobs(owner, repo, runID, JobsFilterLatest)
 	if err != nil {
 		return "", fmt.Errorf("failed to get workflow run jobs: %w", err)
 	}
@@ -583,37 +1103,61 @@ func (c *Client) getJobStepInfo(owner, repo string, runID int64) (string, error)
 	return logContent.String(), nil
 }
 
-// GetWorkflowFileAtRef fetches the workflow file content (YAML) at a specific ref (commit SHA or branch)
+// rawContentHost is the host serving raw file content of github.com repositories
+const rawContentHost = "raw.githubusercontent.com"
+
+// GetWorkflowFileAtRef fetches the workflow file content (YAML) at a specific ref (commit SHA or branch).
+// An empty ref falls back to the default branch of the repository.
 func (c *Client) GetWorkflowFileAtRef(owner, repo, path, ref string) (string, error) {
-	endpoint := fmt.Sprintf("repos/%s/%s/contents/%s?ref=%s", owner, repo, path, ref)
-	httpClient, err := api.DefaultHTTPClient()
+	if ref == "" {
+		// キュー待ちのランなどHeadShaが空の場合
+		defaultBranch, err := c.GetDefaultBranch(owner, repo)
+		if err != nil {
+			return "", err
+		}
+		ref = defaultBranch
+	}
+	// ブランチ名の+や&、パスの空白などがそのままURLに入らないようにエスケープする
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	endpoint := fmt.Sprintf("repos/%s/%s/contents/%s?%s", owner, repo, strings.Join(segments, "/"), url.Values{"ref": {ref}}.Encode())
+	httpClient, err := c.httpClient()
 	if err != nil {
 		return "", categorizeError(err)
 	}
-	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, "https://api.github.com/"+endpoint, nil)
+	// リダイレクトは自前で処理して、トークンを付けるかどうかを判断する
+	httpClient.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+		return http.ErrUseLastResponse
+	}
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, c.apiURL(endpoint), nil)
 	if err != nil {
 		return "", categorizeError(err)
 	}
 	req.Header.Set("Accept", "application/vnd.github.v3+json")
-	resp, err := httpClient.Do(req)
+	resp, body, err := c.doRequest(httpClient, req)
 	if err != nil {
 		return "", categorizeError(err)
 	}
-	defer func() { _ = resp.Body.Close() }()
+	if location := resp.Header.Get("Location"); location != "" && resp.StatusCode >= 300 && resp.StatusCode < 400 {
+		return c.fetchRawContent(resp.Request.URL, location)
+	}
 	if resp.StatusCode != http.StatusOK {
 		return "", categorizeError(fmt.Errorf("status %d", resp.StatusCode))
 	}
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return "", categorizeError(err)
-	}
 	var data struct {
-		Content  string `json:"content"`
-		Encoding string `json:"encoding"`
+		Content     string `json:"content"`
+		Encoding    string `json:"encoding"`
+		DownloadURL string `json:"download_url"`
 	}
 	if err := json.Unmarshal(body, &data); err != nil {
 		return "", categorizeError(err)
 	}
+	// 1MBを超えるファイルは本文が返らないためダウンロードURLから取得する
+	if data.Encoding == "none" && data.DownloadURL != "" {
+		return c.fetchRawContent(req.URL, data.DownloadURL)
+	}
 	if data.Encoding != "base64" {
 		return "", categorizeError(fmt.Errorf("unexpected encoding %s", data.Encoding))
 	}
@@ -623,3 +1167,430 @@ func (c *Client) GetWorkflowFileAtRef(owner, repo, path, ref string) (string, er
 	}
 	return string(decoded), nil
 }
+
+// fetchRawContent downloads raw file content from rawURL, resolved against base.
+// Only the GitHub host of the client and the raw content host are followed. The token is
+// scoped by the go-gh HTTP client, which sends it only to the client's own host.
+func (c *Client) fetchRawContent(base *url.URL, rawURL string) (string, error) {
+	u, err := base.Parse(rawURL)
+	if err != nil {
+		return "", categorizeError(fmt.Errorf("invalid content URL %q: %w", rawURL, err))
+	}
+	if !c.isContentHost(u.Hostname()) {
+		return "", categorizeError(fmt.Errorf("refusing to fetch content from unexpected host %s", u.Hostname()))
+	}
+
+	httpClient, err := c.httpClient()
+	if err != nil {
+		return "", categorizeError(err)
+	}
+	httpClient.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+		if !c.isContentHost(req.URL.Hostname()) {
+			return fmt.Errorf("refusing to follow redirect to unexpected host %s", req.URL.Hostname())
+		}
+		return nil
+	}
+	req, err := http.NewRequest(http.MethodGet, u.String(), nil)
+	if err != nil {
+		return "", categorizeError(err)
+	}
+	resp, body, err := c.doRequest(httpClient, req)
+	if err != nil {
+		return "", categorizeError(err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", categorizeError(fmt.Errorf("status %d", resp.StatusCode))
+	}
+	return string(body), nil
+}
+
+// isContentHost reports whether file content may be fetched from host:
+// the GitHub host of the client, its API host or the raw content host
+func (c *Client) isContentHost(host string) bool {
+	apiURL, err := url.Parse(c.apiURL(""))
+	if err != nil {
+		return false
+	}
+	return strings.EqualFold(host, c.host) || strings.EqualFold(host, apiURL.Hostname()) || strings.EqualFold(host, rawContentHost)
+}
+
+// GetWorkflow returns a single workflow
+func (c *Client) GetWorkflow(owner, repo string, workflowID int64) (*models.Workflow, error) {
+	var workflow models.Workflow
+
+	err := c.retry(func() error {
+		return c.restClient.Get(fmt.Sprintf("repos/%s/%s/actions/workflows/%d", owner, repo, workflowID), &workflow)
+	})
+
+	if err != nil {
+		return nil, categorizeError(err)
+	}
+
+	return &workflow, nil
+}
+
+// GetPreviousRunAttempt returns a workflow run as it was at the given attempt,
+// used to view the logs of an attempt before the run was re-run
+func (c *Client) GetPreviousRunAttempt(owner, repo string, runID int64, attempt int) (*models.WorkflowRun, error) {
+	var run models.WorkflowRun
+
+	err := c.retry(func() error {
+		return c.restClient.Get(fmt.Sprintf("repos/%s/%s/actions/runs/%d/attempts/%d", owner, repo, runID, attempt), &run)
+	})
+
+	if err != nil {
+		return nil, categorizeError(err)
+	}
+
+	return &run, nil
+}
+
+// GetWorkflowDispatchInputs returns the workflow_dispatch inputs defined in the workflow file at HEAD
+func (c *Client) GetWorkflowDispatchInputs(owner, repo string, workflowID int64) ([]models.WorkflowDispatchInput, error) {
+	workflow, err := c.GetWorkflow(owner, repo, workflowID)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := c.GetWorkflowFileAtRef(owner, repo, workflow.Path, "HEAD")
+	if err != nil {
+		return nil, err
+	}
+
+	return parseWorkflowDispatchInputs(content)
+}
+
+// parseWorkflowDispatchInputs parses the on.workflow_dispatch.inputs block of a workflow file
+func parseWorkflowDispatchInputs(content string) ([]models.WorkflowDispatchInput, error) {
+	var doc struct {
+		On yaml.Node `yaml:"on"`
+	}
+	if err := yaml.Unmarshal([]byte(content), &doc); err != nil {
+		return nil, fmt.Errorf("failed to parse workflow file: %w", err)
+	}
+
+	// "on" can be a string, a list of event names, or a mapping of events
+	switch doc.On.Kind {
+	case yaml.ScalarNode:
+		if doc.On.Value == "workflow_dispatch" {
+			return nil, nil
+		}
+	case yaml.SequenceNode:
+		for _, event := range doc.On.Content {
+			if event.Value == "workflow_dispatch" {
+				return nil, nil
+			}
+		}
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(doc.On.Content); i += 2 {
+			if doc.On.Content[i].Value != "workflow_dispatch" {
+				continue
+			}
+			var dispatch struct {
+				Inputs yaml.Node `yaml:"inputs"`
+			}
+			if err := doc.On.Content[i+1].Decode(&dispatch); err != nil {
+				return nil, fmt.Errorf("failed to parse workflow_dispatch: %w", err)
+			}
+			return decodeDispatchInputs(&dispatch.Inputs)
+		}
+	}
+
+	return nil, fmt.Errorf("workflow does not have a workflow_dispatch trigger")
+}
+
+// decodeDispatchInputs decodes workflow_dispatch inputs keeping their declaration order
+func decodeDispatchInputs(node *yaml.Node) ([]models.WorkflowDispatchInput, error) {
+	if node.Kind != yaml.MappingNode {
+		return nil, nil
+	}
+
+	var inputs []models.WorkflowDispatchInput
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		var spec struct {
+			Description string    `yaml:"description"`
+			Type        string    `yaml:"type"`
+			Default     yaml.Node `yaml:"default"`
+			Required    bool      `yaml:"required"`
+			Options     []string  `yaml:"options"`
+		}
+		if err := node.Content[i+1].Decode(&spec); err != nil {
+			return nil, fmt.Errorf("failed to parse input %s: %w", node.Content[i].Value, err)
+		}
+		inputType := spec.Type
+		if inputType == "" {
+			inputType = "string"
+		}
+		inputs = append(inputs, models.WorkflowDispatchInput{
+			Name:        node.Content[i].Value,
+			Description: spec.Description,
+			Type:        inputType,
+			Default:     spec.Default.Value,
+			Required:    spec.Required,
+			Options:     spec.Options,
+		})
+	}
+
+	return inputs, nil
+}
+
+// TriggerWorkflowDispatch triggers a workflow_dispatch event for a workflow
+func (c *Client) TriggerWorkflowDispatch(owner, repo, ref string, workflowID int64, inputs map[string]string) error {
+	payload := struct {
+		Ref    string            `json:"ref"`
+		Inputs map[string]string `json:"inputs,omitempty"`
+	}{
+		Ref:    ref,
+		Inputs: inputs,
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to encode dispatch request: %w", err)
+	}
+
+	err = c.limit(func() error {
+		return c.restClient.Post(fmt.Sprintf("repos/%s/%s/actions/workflows/%d/dispatches", owner, repo, workflowID), bytes.NewReader(body), nil)
+	})
+	if err != nil {
+		return categorizeError(err)
+	}
+
+	return nil
+}
+
+// GetWorkflowRunArtifacts returns the artifacts uploaded by a workflow run
+func (c *Client) GetWorkflowRunArtifacts(owner, repo string, runID int64) ([]models.Artifact, error) {
+	response := struct {
+		Artifacts []models.Artifact `json:"artifacts"`
+	}{}
+
+	err := c.retry(func() error {
+		return c.restClient.Get(fmt.Sprintf("repos/%s/%s/actions/runs/%d/artifacts?per_page=100", owner, repo, runID), &response)
+	})
+
+	if err != nil {
+		return nil, categorizeError(err)
+	}
+
+	return response.Artifacts, nil
+}
+
+// GetCheckSuiteRuns returns the check runs of a check suite as workflow runs (name, status, conclusion and start time).
+// Jobs of reusable workflows are named "caller job / called job".
+func (c *Client) GetCheckSuiteRuns(owner, repo string, checkSuiteID int64) ([]models.WorkflowRun, error) {
+	response := struct {
+		CheckRuns []struct {
+			ID         int64     `json:"id"`
+			Name       string    `json:"name"`
+			Status     string    `json:"status"`
+			Conclusion string    `json:"conclusion"`
+			HTMLURL    string    `json:"html_url"`
+			StartedAt  time.Time `json:"started_at"`
+		} `json:"check_runs"`
+	}{}
+
+	err := c.retry(func() error {
+		return c.restClient.Get(fmt.Sprintf("repos/%s/%s/check-suites/%d/check-runs?per_page=100", owner, repo, checkSuiteID), &response)
+	})
+
+	if err != nil {
+		return nil, categorizeError(err)
+	}
+
+	runs := make([]models.WorkflowRun, len(response.CheckRuns))
+	for i, checkRun := range response.CheckRuns {
+		runs[i] = models.WorkflowRun{
+			ID:           checkRun.ID,
+			Name:         checkRun.Name,
+			CheckSuiteID: checkSuiteID,
+			Status:       checkRun.Status,
+			Conclusion:   checkRun.Conclusion,
+			HTMLURL:      checkRun.HTMLURL,
+			CreatedAt:    checkRun.StartedAt,
+			RunStartedAt: checkRun.StartedAt,
+		}
+	}
+	return runs, nil
+}
+
+// GetActionsCacheUsage returns the total size in bytes of the active Actions caches of a repository
+func (c *Client) GetActionsCacheUsage(owner, repo string) (int64, error) {
+	response := struct {
+		ActiveCachesSizeInBytes int64 `json:"active_caches_size_in_bytes"`
+	}{}
+
+	err := c.retry(func() error {
+		return c.restClient.Get(fmt.Sprintf("repos/%s/%s/actions/cache/usage", owner, repo), &response)
+	})
+
+	if err != nil {
+		return 0, categorizeError(err)
+	}
+
+	return response.ActiveCachesSizeInBytes, nil
+}
+
+// ListActionsCaches returns the Actions caches of a repository, largest first (at most 100)
+func (c *Client) ListActionsCaches(owner, repo string) ([]models.ActionsCacheEntry, error) {
+	response := struct {
+		ActionsCaches []models.ActionsCacheEntry `json:"actions_caches"`
+	}{}
+
+	err := c.retry(func() error {
+		return c.restClient.Get(fmt.Sprintf("repos/%s/%s/actions/caches?per_page=100&sort=size_in_bytes&direction=desc", owner, repo), &response)
+	})
+
+	if err != nil {
+		return nil, categorizeError(err)
+	}
+
+	return response.ActionsCaches, nil
+}
+
+// DeleteActionsCache deletes an Actions cache by its ID
+func (c *Client) DeleteActionsCache(owner, repo string, cacheID int64) error {
+	err := c.retry(func() error {
+		return c.restClient.Delete(fmt.Sprintf("repos/%s/%s/actions/caches/%d", owner, repo, cacheID), nil)
+	})
+	if err != nil {
+		return categorizeError(err)
+	}
+
+	return nil
+}
+
+// GetWorkflowRunUsage returns the billable time of a workflow run per OS
+func (c *Client) GetWorkflowRunUsage(owner, repo string, runID int64) (*models.WorkflowRunUsage, error) {
+	var usage models.WorkflowRunUsage
+
+	err := c.retry(func() error {
+		return c.restClient.Get(fmt.Sprintf("repos/%s/%s/actions/runs/%d/timing", owner, repo, runID), &usage)
+	})
+
+	if err != nil {
+		return nil, categorizeError(err)
+	}
+
+	return &usage, nil
+}
+
+// GetCheckRunAnnotations returns the annotations of a check run.
+// The check run ID of a workflow job is the same as the job ID.
+func (c *Client) GetCheckRunAnnotations(owner, repo string, checkRunID int64) ([]models.Annotation, error) {
+	var annotations []models.Annotation
+
+	err := c.retry(func() error {
+		return c.restClient.Get(fmt.Sprintf("repos/%s/%s/check-runs/%d/annotations?per_page=100", owner, repo, checkRunID), &annotations)
+	})
+
+	if err != nil {
+		return nil, categorizeError(err)
+	}
+
+	return annotations, nil
+}
+
+// progressWriter counts the bytes written through it and reports the running total
+type progressWriter struct {
+	written  int64
+	progress func(written int64)
+}
+
+// Write implements io.Writer
+func (w *progressWriter) Write(p []byte) (int, error) {
+	w.written += int64(len(p))
+	if w.progress != nil {
+		w.progress(w.written)
+	}
+	return len(p), nil
+}
+
+// DownloadArtifact downloads the ZIP archive of an artifact and saves it to path.
+// progress, if not nil, is called with the number of bytes saved so far.
+func (c *Client) DownloadArtifact(owner, repo string, artifactID int64, path string, progress func(written int64)) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("failed to create directory for %s: %w", path, err)
+	}
+	return c.limit(func() error {
+		return c.saveArtifact(owner, repo, artifactID, path, progress)
+	})
+}
+
+// saveArtifact streams the ZIP archive of an artifact to path
+func (c *Client) saveArtifact(owner, repo string, artifactID int64, path string, progress func(written int64)) error {
+	// リダイレクト先のストレージURLまで追従する
+	resp, err := c.restClient.Request(http.MethodGet, fmt.Sprintf("repos/%s/%s/actions/artifacts/%d/zip", owner, repo, artifactID), nil)
+	if err != nil {
+		return categorizeError(err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	file, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", path, err)
+	}
+	body := io.TeeReader(resp.Body, &progressWriter{progress: progress})
+	if _, err := io.Copy(file, body); err != nil {
+		_ = file.Close()
+		_ = os.Remove(path)
+		return fmt.Errorf("failed to save artifact to %s: %w", path, err)
+	}
+	return file.Close()
+}
+
+// CancelWorkflowRun cancels a workflow run
+func (c *Client) CancelWorkflowRun(owner, repo string, runID int64) error {
+	return c.postRunAction(owner, repo, runID, "cancel")
+}
+
+// RerunWorkflowRun re-runs a workflow run
+func (c *Client) RerunWorkflowRun(owner, repo string, runID int64) error {
+	return c.postRunAction(owner, repo, runID, "rerun")
+}
+
+// DisableWorkflow disables a workflow so that it is no longer triggered
+func (c *Client) DisableWorkflow(owner, repo string, workflowID int64) error {
+	return c.putWorkflowAction(owner, repo, workflowID, "disable")
+}
+
+// EnableWorkflow enables a disabled workflow
+func (c *Client) EnableWorkflow(owner, repo string, workflowID int64) error {
+	return c.putWorkflowAction(owner, repo, workflowID, "enable")
+}
+
+// putWorkflowAction sends a PUT request to a workflow action endpoint (e.g. disable, enable)
+func (c *Client) putWorkflowAction(owner, repo string, workflowID int64, action string) error {
+	err := c.limit(func() error {
+		resp, err := c.restClient.Request(http.MethodPut, fmt.Sprintf("repos/%s/%s/actions/workflows/%d/%s", owner, repo, workflowID, action), nil)
+		if err != nil {
+			return err
+		}
+		// 成功時は204でボディは空
+		_ = resp.Body.Close()
+		return nil
+	})
+	if err != nil {
+		return categorizeError(err)
+	}
+
+	return nil
+}
+
+// postRunAction sends a POST request to a workflow run action endpoint (e.g. cancel, rerun)
+func (c *Client) postRunAction(owner, repo string, runID int64, action string) error {
+	err := c.limit(func() error {
+		resp, err := c.restClient.Request(http.MethodPost, fmt.Sprintf("repos/%s/%s/actions/runs/%d/%s", owner, repo, runID, action), nil)
+		if err != nil {
+			return err
+		}
+		// レスポンスボディは空または{}なので読み捨てる
+		_ = resp.Body.Close()
+		return nil
+	})
+	if err != nil {
+		return categorizeError(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,544 @@
+package github
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cli/go-gh/v2/pkg/api"
+)
+
+// testHost is the GitHub Enterprise Server host of the test clients
+const testHost = "ghe.example.com"
+
+// hostRouter is an http.RoundTripper that sends requests for each host to a test server
+// and records the Authorization header received per host
+type hostRouter struct {
+	servers map[string]*httptest.Server
+
+	mu            sync.Mutex
+	authorization map[string][]string
+}
+
+func (r *hostRouter) RoundTrip(req *http.Request) (*http.Response, error) {
+	server, ok := r.servers[req.URL.Hostname()]
+	if !ok {
+		return nil, fmt.Errorf("unexpected request to %s", req.URL.Host)
+	}
+
+	r.mu.Lock()
+	r.authorization[req.URL.Hostname()] = append(r.authorization[req.URL.Hostname()], req.Header.Get("Authorization"))
+	r.mu.Unlock()
+
+	routed := req.Clone(req.Context())
+	routed.URL.Scheme = "http"
+	routed.URL.Host = strings.TrimPrefix(server.URL, "http://")
+	return http.DefaultTransport.RoundTrip(routed)
+}
+
+// newTestClient returns a client for testHost whose requests are routed by host to the given handlers
+func newTestClient(t *testing.T, handlers map[string]http.HandlerFunc) (*Client, *hostRouter) {
+	t.Helper()
+	router := &hostRouter{servers: map[string]*httptest.Server{}, authorization: map[string][]string{}}
+	for host, handler := range handlers {
+		server := httptest.NewServer(handler)
+		t.Cleanup(server.Close)
+		router.servers[host] = server
+	}
+
+	transport := newRateLimitTransport()
+	transport.base = router
+	restClient, err := api.NewRESTClient(api.ClientOptions{Host: testHost, AuthToken: "secret-token", Transport: transport})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &Client{
+		restClient:  *restClient,
+		retryConfig: RetryConfig{MaxRetries: 0},
+		requests:    make(chan struct{}, DefaultConcurrentRequests),
+		host:        testHost,
+		transport:   transport,
+		authToken:   "secret-token",
+	}, router
+}
+
+func TestGetWorkflowFileAtRefDoesNotSendTokenToRawHost(t *testing.T) {
+	tests := []struct {
+		name     string
+		contents http.HandlerFunc
+	}{
+		{
+			name: "redirect",
+			contents: func(w http.ResponseWriter, r *http.Request) {
+				http.Redirect(w, r, "https://"+rawContentHost+"/o/r/main/.github/workflows/ci.yml", http.StatusFound)
+			},
+		},
+		{
+			name: "download URL of a large file",
+			contents: func(w http.ResponseWriter, r *http.Request) {
+				_ = json.NewEncoder(w).Encode(map[string]string{
+					"encoding":     "none",
+					"download_url": "https://" + rawContentHost + "/o/r/main/.github/workflows/ci.yml",
+				})
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, router := newTestClient(t, map[string]http.HandlerFunc{
+				testHost: tt.contents,
+				rawContentHost: func(w http.ResponseWriter, r *http.Request) {
+					_, _ = w.Write([]byte("name: CI\n"))
+				},
+			})
+
+			content, err := client.GetWorkflowFileAtRef("o", "r", ".github/workflows/ci.yml", "main")
+			if err != nil {
+				t.Fatalf("GetWorkflowFileAtRef() error = %v", err)
+			}
+			if content != "name: CI\n" {
+				t.Errorf("content = %q, want %q", content, "name: CI\n")
+			}
+			if got := router.authorization[testHost]; len(got) != 1 || got[0] != "token secret-token" {
+				t.Errorf("Authorization sent to the API host = %q, want the token", got)
+			}
+			if got := router.authorization[rawContentHost]; len(got) != 1 || got[0] != "" {
+				t.Errorf("Authorization sent to the raw host = %q, want none", got)
+			}
+		})
+	}
+}
+
+func TestGetWorkflowFileAtRefDecodesContent(t *testing.T) {
+	client, _ := newTestClient(t, map[string]http.HandlerFunc{
+		testHost: func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/api/v3/repos/o/r/contents/.github/workflows/ci.yml" || r.URL.Query().Get("ref") != "abc123" {
+				http.NotFound(w, r)
+				return
+			}
+			_ = json.NewEncoder(w).Encode(map[string]string{
+				"encoding": "base64",
+				"content":  base64.StdEncoding.EncodeToString([]byte("name: CI\n")),
+			})
+		},
+	})
+
+	content, err := client.GetWorkflowFileAtRef("o", "r", ".github/workflows/ci.yml", "abc123")
+	if err != nil {
+		t.Fatalf("GetWorkflowFileAtRef() error = %v", err)
+	}
+	if content != "name: CI\n" {
+		t.Errorf("content = %q, want %q", content, "name: CI\n")
+	}
+}
+
+func TestGetWorkflowFileAtRefEscapesRefAndPath(t *testing.T) {
+	client, _ := newTestClient(t, map[string]http.HandlerFunc{
+		testHost: func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.EscapedPath() != "/api/v3/repos/o/r/contents/.github/workflows/ci%20build.yml" || r.URL.Query().Get("ref") != "feat/a+b&c" {
+				t.Errorf("request = %s?%s, want the path and ref escaped", r.URL.EscapedPath(), r.URL.RawQuery)
+				http.NotFound(w, r)
+				return
+			}
+			_ = json.NewEncoder(w).Encode(map[string]string{
+				"encoding": "base64",
+				"content":  base64.StdEncoding.EncodeToString([]byte("name: CI\n")),
+			})
+		},
+	})
+
+	if _, err := client.GetWorkflowFileAtRef("o", "r", ".github/workflows/ci build.yml", "feat/a+b&c"); err != nil {
+		t.Fatalf("GetWorkflowFileAtRef() error = %v", err)
+	}
+}
+
+func TestGetWorkflowFileAtRefRejectsUnexpectedHost(t *testing.T) {
+	tests := []struct {
+		name     string
+		contents http.HandlerFunc
+	}{
+		{
+			name: "redirect",
+			contents: func(w http.ResponseWriter, r *http.Request) {
+				http.Redirect(w, r, "https://evil.example.net/ci.yml", http.StatusFound)
+			},
+		},
+		{
+			name: "download URL",
+			contents: func(w http.ResponseWriter, r *http.Request) {
+				_ = json.NewEncoder(w).Encode(map[string]string{
+					"encoding":     "none",
+					"download_url": "https://evil.example.net/ci.yml",
+				})
+			},
+		},
+		{
+			name: "redirect from the raw host",
+			contents: func(w http.ResponseWriter, r *http.Request) {
+				http.Redirect(w, r, "https://"+rawContentHost+"/ci.yml", http.StatusFound)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, router := newTestClient(t, map[string]http.HandlerFunc{
+				testHost: tt.contents,
+				rawContentHost: func(w http.ResponseWriter, r *http.Request) {
+					http.Redirect(w, r, "https://evil.example.net/ci.yml", http.StatusFound)
+				},
+				"evil.example.net": func(w http.ResponseWriter, r *http.Request) {
+					_, _ = w.Write([]byte("name: Evil\n"))
+				},
+			})
+
+			if _, err := client.GetWorkflowFileAtRef("o", "r", ".github/workflows/ci.yml", "main"); err == nil {
+				t.Error("GetWorkflowFileAtRef() error = nil, want an error")
+			}
+			if got := router.authorization["evil.example.net"]; len(got) != 0 {
+				t.Errorf("%d requests reached the unexpected host", len(got))
+			}
+		})
+	}
+}
+
+func TestIsContentHost(t *testing.T) {
+	client := &Client{host: "github.com"}
+	for host, want := range map[string]bool{
+		"github.com":                true,
+		"api.github.com":            true,
+		"raw.githubusercontent.com": true,
+		"GitHub.com":                true,
+		"evil.github.com.example":   false,
+		"example.com":               false,
+	} {
+		if got := client.isContentHost(host); got != want {
+			t.Errorf("isContentHost(%q) = %v, want %v", host, got, want)
+		}
+	}
+}
+
+func TestDownloadWorkflowRunLogsForbidden(t *testing.T) {
+	const logsPath = "/api/v3/repos/o/r/actions/runs/1/logs"
+	const storageHost = "logs.blob.example.net"
+	tests := []struct {
+		name          string
+		logs          http.HandlerFunc
+		storage       http.HandlerFunc
+		wantRestrict  bool
+		wantRateLimit bool
+	}{
+		{
+			name: "restricted by organization policy",
+			logs: func(w http.ResponseWriter, r *http.Request) {
+				http.Error(w, `{"message":"Must have admin rights to Repository."}`, http.StatusForbidden)
+			},
+			wantRestrict: true,
+		},
+		{
+			name: "storage refuses the download",
+			logs: func(w http.ResponseWriter, r *http.Request) {
+				http.Redirect(w, r, "https://"+storageHost+"/run.zip?sig=signed", http.StatusFound)
+			},
+			storage: func(w http.ResponseWriter, r *http.Request) {
+				http.Error(w, "AuthorizationFailure", http.StatusForbidden)
+			},
+			wantRestrict: true,
+		},
+		{
+			name: "secondary rate limit with Retry-After",
+			logs: func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Retry-After", "60")
+				http.Error(w, `{"message":"Forbidden"}`, http.StatusForbidden)
+			},
+			wantRateLimit: true,
+		},
+		{
+			name: "secondary rate limit message",
+			logs: func(w http.ResponseWriter, r *http.Request) {
+				http.Error(w, `{"message":"You have exceeded a secondary rate limit. Please wait a few minutes before you try again."}`, http.StatusForbidden)
+			},
+			wantRateLimit: true,
+		},
+		{
+			name: "primary rate limit exhausted",
+			logs: func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("X-RateLimit-Remaining", "0")
+				w.Header().Set("X-RateLimit-Limit", "5000")
+				http.Error(w, `{"message":"API rate limit exceeded"}`, http.StatusForbidden)
+			},
+			wantRateLimit: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handlers := map[string]http.HandlerFunc{
+				testHost: func(w http.ResponseWriter, r *http.Request) {
+					if r.URL.Path != logsPath {
+						http.NotFound(w, r)
+						return
+					}
+					tt.logs(w, r)
+				},
+			}
+			if tt.storage != nil {
+				handlers[storageHost] = tt.storage
+			}
+			client, router := newTestClient(t, handlers)
+
+			_, err := client.downloadWorkflowRunLogs("o", "r", 1)
+			if got := errors.Is(err, ErrLogsRestricted); got != tt.wantRestrict {
+				t.Errorf("errors.Is(err, ErrLogsRestricted) = %v, want %v (err = %v)", got, tt.wantRestrict, err)
+			}
+			var ghErr *GitHubError
+			if got := errors.As(err, &ghErr) && ghErr.Type == ErrorTypeRateLimit; got != tt.wantRateLimit {
+				t.Errorf("rate limit error = %v, want %v (err = %v)", got, tt.wantRateLimit, err)
+			}
+			if got := router.authorization[storageHost]; len(got) > 0 && got[0] != "" {
+				t.Errorf("Authorization sent to the log storage = %q, want none", got[0])
+			}
+		})
+	}
+}
+
+func TestCategorizeErrorForbidden(t *testing.T) {
+	tests := []struct {
+		name    string
+		headers http.Header
+		message string
+		want    ErrorType
+	}{
+		{
+			name:    "missing permission",
+			message: "Resource not accessible by integration",
+			want:    ErrorTypePermission,
+		},
+		{
+			name:    "Retry-After",
+			headers: http.Header{"Retry-After": []string{"30"}},
+			message: "Forbidden",
+			want:    ErrorTypeRateLimit,
+		},
+		{
+			name:    "secondary rate limit message",
+			message: "You have exceeded a secondary rate limit",
+			want:    ErrorTypeRateLimit,
+		},
+		{
+			name:    "rate limit remaining exhausted",
+			headers: http.Header{"X-Ratelimit-Remaining": []string{"0"}},
+			message: "API rate limit exceeded for user ID 1.",
+			want:    ErrorTypeRateLimit,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			headers := tt.headers
+			if headers == nil {
+				headers = http.Header{}
+			}
+			err := &api.HTTPError{StatusCode: http.StatusForbidden, Headers: headers, Message: tt.message}
+			if got := categorizeError(err).Type; got != tt.want {
+				t.Errorf("categorizeError().Type = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// buildLogZip returns a log archive containing the named files in the given order
+func buildLogZip(t *testing.T, names []string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	writer := zip.NewWriter(&buf)
+	for _, name := range names {
+		file, err := writer.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := file.Write([]byte("log of " + name)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestSortLogFiles(t *testing.T) {
+	tests := []struct {
+		name  string
+		files []string
+		want  []string
+	}{
+		{
+			name:  "steps numbered past 9",
+			files: []string{"build/10_Run tests.txt", "build/2_Checkout.txt", "build/1_Set up job.txt", "build/11_Post Checkout.txt"},
+			want:  []string{"build/1_Set up job.txt", "build/2_Checkout.txt", "build/10_Run tests.txt", "build/11_Post Checkout.txt"},
+		},
+		{
+			name:  "jobs are kept together",
+			files: []string{"test/2_Run.txt", "build/10_Upload.txt", "test/1_Set up job.txt", "build/3_Build.txt"},
+			want:  []string{"build/3_Build.txt", "build/10_Upload.txt", "test/1_Set up job.txt", "test/2_Run.txt"},
+		},
+		{
+			name:  "files without a step number come first",
+			files: []string{"build/1_Set up job.txt", "build/system.txt", "0_build.txt", "1_test.txt"},
+			want:  []string{"0_build.txt", "1_test.txt", "build/system.txt", "build/1_Set up job.txt"},
+		},
+		{
+			name:  "same step number sorted by name",
+			files: []string{"build/3_b.txt", "build/3_a.txt"},
+			want:  []string{"build/3_a.txt", "build/3_b.txt"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := buildLogZip(t, tt.files)
+			reader, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var got []string
+			for _, file := range sortLogFiles(reader.File) {
+				got = append(got, file.Name)
+			}
+			if strings.Join(got, "\n") != strings.Join(tt.want, "\n") {
+				t.Errorf("sortLogFiles() = %q, want %q", got, tt.want)
+			}
+
+			// 展開したログのセクションも同じ順序になる
+			logs, err := (&Client{}).extractLogsFromZip(data)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(logs.Sections) != len(tt.want) {
+				t.Fatalf("extractLogsFromZip() returned %d sections, want %d", len(logs.Sections), len(tt.want))
+			}
+			for i, section := range logs.Sections {
+				if section.FileName != tt.want[i] {
+					t.Errorf("section %d = %q, want %q", i, section.FileName, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestGetWorkflowRunsForPR(t *testing.T) {
+	pullRequests := 0
+	client, _ := newTestClient(t, map[string]http.HandlerFunc{
+		testHost: func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/api/v3/repos/o/r/pulls/42":
+				pullRequests++
+				_, _ = w.Write([]byte(`{"number":42,"head":{"ref":"feature/login","sha":"abc123","repo":{"full_name":"alice/r"}}}`))
+			case "/api/v3/repos/o/r/actions/runs":
+				query := r.URL.Query()
+				if query.Get("branch") != "feature/login" || query.Get("event") != "pull_request" || query.Get("page") != "2" {
+					t.Errorf("runs query = %s, want the head branch of the PR", r.URL.RawQuery)
+				}
+				// 同名ブランチの別PR(フォーク・同一リポジトリ)のランが混ざる
+				_, _ = w.Write([]byte(`{"total_count":31,"workflow_runs":[
+					{"id":7,"head_branch":"feature/login","head_repository":{"full_name":"alice/r"}},
+					{"id":8,"head_branch":"feature/login","head_repository":{"full_name":"bob/r"}},
+					{"id":9,"head_branch":"feature/login","head_repository":{"full_name":"o/r"},"pull_requests":[{"number":43}]},
+					{"id":10,"head_branch":"feature/login","head_repository":{"full_name":"o/r"},"pull_requests":[{"number":42}]}
+				]}`))
+			default:
+				http.NotFound(w, r)
+			}
+		},
+	})
+
+	for range 2 {
+		runs, total, err := client.GetWorkflowRunsForPR("o", "r", 42, 2, 30)
+		if err != nil {
+			t.Fatalf("GetWorkflowRunsForPR() error = %v", err)
+		}
+		var ids []int64
+		for _, run := range runs {
+			ids = append(ids, run.ID)
+		}
+		if !slices.Equal(ids, []int64{7, 10}) || total != 31 {
+			t.Errorf("GetWorkflowRunsForPR() = %v, %d, want runs [7 10] of 31", ids, total)
+		}
+	}
+	if pullRequests != 1 {
+		t.Errorf("pull request fetched %d times, want once", pullRequests)
+	}
+}
+
+func TestRetryConfigFromEnv(t *testing.T) {
+	tests := []struct {
+		name    string
+		env     map[string]string
+		want    RetryConfig
+		wantErr string
+	}{
+		{
+			name: "defaults",
+			want: DefaultRetryConfig(),
+		},
+		{
+			name: "overridden",
+			env:  map[string]string{MaxRetriesEnv: "5", InitialDelayMsEnv: "200", MaxDelayMsEnv: "200"},
+			want: RetryConfig{MaxRetries: 5, InitialDelay: 200 * time.Millisecond, MaxDelay: 200 * time.Millisecond},
+		},
+		{
+			name:    "too many retries",
+			env:     map[string]string{MaxRetriesEnv: "1000000"},
+			wantErr: "must be between 0 and 10",
+		},
+		{
+			name:    "zero max delay",
+			env:     map[string]string{InitialDelayMsEnv: "0", MaxDelayMsEnv: "0"},
+			wantErr: "must be greater than 0",
+		},
+		{
+			name:    "max delay below initial delay",
+			env:     map[string]string{InitialDelayMsEnv: "5000", MaxDelayMsEnv: "1000"},
+			wantErr: "must not be less than " + InitialDelayMsEnv,
+		},
+		{
+			name:    "delay overflowing time.Duration",
+			env:     map[string]string{MaxDelayMsEnv: "9223372036854"},
+			wantErr: "must be between 0 and 600000",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, name := range []string{MaxRetriesEnv, InitialDelayMsEnv, MaxDelayMsEnv} {
+				if value, ok := tt.env[name]; ok {
+					t.Setenv(name, value)
+				} else {
+					t.Setenv(name, "")
+				}
+			}
+
+			got, err := retryConfigFromEnv()
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("retryConfigFromEnv() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("retryConfigFromEnv() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("retryConfigFromEnv() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,110 @@
+package github
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DebugLogSize is the number of API requests kept by the debug log
+const DebugLogSize = 20
+
+// DebugEntry represents an API request recorded by the debug log
+type DebugEntry struct {
+	Time        time.Time
+	Method      string
+	URL         string
+	Status      int // 0 when the request failed without a response
+	Duration    time.Duration
+	ContentType string
+	Body        []byte // only captured for JSON responses
+	Err         error
+}
+
+// debugTransport is an http.RoundTripper that records the last DebugLogSize requests in a ring buffer
+type debugTransport struct {
+	base http.RoundTripper
+
+	mu      sync.Mutex
+	entries [DebugLogSize]DebugEntry
+	next    int
+	count   int
+}
+
+func newDebugTransport(base http.RoundTripper) *debugTransport {
+	return &debugTransport{base: base}
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *debugTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	entry := DebugEntry{Time: time.Now(), Method: req.Method, URL: redactURL(req.URL)}
+	resp, err := t.base.RoundTrip(req)
+	entry.Duration = time.Since(entry.Time)
+	if err != nil {
+		entry.Err = err
+		t.record(entry)
+		return resp, err
+	}
+
+	entry.Status = resp.StatusCode
+	entry.ContentType = resp.Header.Get("Content-Type")
+	// ログのZIPなど大きなバイナリは読み込まない
+	if strings.Contains(entry.ContentType, "json") {
+		body, readErr := io.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+		if readErr != nil {
+			entry.Err = readErr
+		}
+		entry.Body = body
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+	}
+	t.record(entry)
+
+	return resp, nil
+}
+
+// record adds an entry to the ring buffer, overwriting the oldest one when full
+func (t *debugTransport) record(entry DebugEntry) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.entries[t.next] = entry
+	t.next = (t.next + 1) % DebugLogSize
+	t.count = min(t.count+1, DebugLogSize)
+}
+
+// snapshot returns the recorded entries from oldest to newest
+func (t *debugTransport) snapshot() []DebugEntry {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	entries := make([]DebugEntry, 0, t.count)
+	start := (t.next - t.count + DebugLogSize) % DebugLogSize
+	for i := 0; i < t.count; i++ {
+		entries = append(entries, t.entries[(start+i)%DebugLogSize])
+	}
+	return entries
+}
+
+// EnableDebugLog starts recording the API requests made by the client
+func (c *Client) EnableDebugLog() {
+	if c.debug != nil {
+		return
+	}
+	c.debug = newDebugTransport(c.transport.base)
+	c.transport.base = c.debug
+}
+
+// DebugLogEnabled reports whether the client records its API requests
+func (c *Client) DebugLogEnabled() bool {
+	return c.debug != nil
+}
+
+// DebugLog returns the last recorded API requests from oldest to newest
+func (c *Client) DebugLog() []DebugEntry {
+	if c.debug == nil {
+		return nil
+	}
+	return c.debug.snapshot()
+}
@@ -0,0 +1,55 @@
+package github
+
+import (
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// loggingTransport is an http.RoundTripper that logs every API request with its status and duration
+type loggingTransport struct {
+	base   http.RoundTripper
+	logger *slog.Logger
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	resp, err := t.base.RoundTrip(req)
+	attrs := []any{
+		slog.String("method", req.Method),
+		slog.String("url", redactURL(req.URL)),
+		slog.Duration("duration", time.Since(start)),
+	}
+	if err != nil {
+		t.logger.Error("api request failed", append(attrs, slog.String("error", err.Error()))...)
+		return resp, err
+	}
+	t.logger.Info("api request", append(attrs, slog.Int("status", resp.StatusCode))...)
+	return resp, nil
+}
+
+// SetLogger logs every API request made by the client to logger
+func (c *Client) SetLogger(logger *slog.Logger) {
+	c.transport.base = &loggingTransport{base: c.transport.base, logger: logger}
+}
+
+// redactURL returns the URL with the values of its query parameters removed.
+// Redirects to log and artifact storage carry signed (SAS) tokens in the query.
+func redactURL(u *url.URL) string {
+	redacted := *u
+	redacted.User = nil
+	if u.RawQuery == "" {
+		return redacted.String()
+	}
+
+	var keys []string
+	for _, pair := range strings.Split(u.RawQuery, "&") {
+		key, _, _ := strings.Cut(pair, "=")
+		keys = append(keys, key+"=REDACTED")
+	}
+	redacted.RawQuery = strings.Join(keys, "&")
+	return redacted.String()
+}
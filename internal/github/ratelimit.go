@@ -0,0 +1,53 @@
+package github
+
+import (
+	"net/http"
+	"strconv"
+	"sync"
+)
+
+// RateLimit represents the API rate-limit usage reported by the last response
+type RateLimit struct {
+	Remaining int
+	Limit     int
+}
+
+// rateLimitTransport is an http.RoundTripper that records the rate-limit headers of every response
+type rateLimitTransport struct {
+	base http.RoundTripper
+
+	mu        sync.RWMutex
+	rateLimit RateLimit
+	known     bool
+}
+
+func newRateLimitTransport() *rateLimitTransport {
+	return &rateLimitTransport{base: http.DefaultTransport}
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+
+	remaining, errRemaining := strconv.Atoi(resp.Header.Get("X-RateLimit-Remaining"))
+	limit, errLimit := strconv.Atoi(resp.Header.Get("X-RateLimit-Limit"))
+	// レート制限が無効なGHESなどではヘッダーが返らない
+	if errRemaining == nil && errLimit == nil {
+		t.mu.Lock()
+		t.rateLimit = RateLimit{Remaining: remaining, Limit: limit}
+		t.known = true
+		t.mu.Unlock()
+	}
+
+	return resp, nil
+}
+
+// current returns the last recorded rate limit and whether any has been recorded yet
+func (t *rateLimitTransport) current() (RateLimit, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.rateLimit, t.known
+}
@@ -0,0 +1,77 @@
+package github
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// ErrScopesNotReported is returned when the API does not report OAuth scopes for the token
+// (e.g. fine-grained personal access tokens and GitHub App tokens)
+var ErrScopesNotReported = errors.New("token scopes are not reported for this token type")
+
+// scopesTransport is an http.RoundTripper that records the X-OAuth-Scopes header of every response
+type scopesTransport struct {
+	base http.RoundTripper
+
+	mu     sync.RWMutex
+	scopes []string
+	known  bool
+}
+
+func newScopesTransport(base http.RoundTripper) *scopesTransport {
+	return &scopesTransport{base: base}
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *scopesTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+
+	// スコープが空のトークンでもヘッダー自体は返るため、存在有無で判定する
+	values, ok := resp.Header[http.CanonicalHeaderKey("X-OAuth-Scopes")]
+	if ok {
+		var scopes []string
+		for _, value := range values {
+			for _, scope := range strings.Split(value, ",") {
+				if scope = strings.TrimSpace(scope); scope != "" {
+					scopes = append(scopes, scope)
+				}
+			}
+		}
+		t.mu.Lock()
+		t.scopes = scopes
+		t.known = true
+		t.mu.Unlock()
+	}
+
+	return resp, nil
+}
+
+// current returns the last recorded scopes and whether any have been recorded yet
+func (t *scopesTransport) current() ([]string, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.scopes, t.known
+}
+
+// GetTokenScopes returns the OAuth scopes granted to the current token
+func (c *Client) GetTokenScopes() ([]string, error) {
+	// スコープはレスポンスヘッダーからtransportが記録する
+	var response struct{}
+	err := c.retry(func() error {
+		return c.restClient.Get("user", &response)
+	})
+	if err != nil {
+		return nil, categorizeError(err)
+	}
+
+	scopes, ok := c.scopes.current()
+	if !ok {
+		return nil, ErrScopesNotReported
+	}
+	return scopes, nil
+}
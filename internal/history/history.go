@@ -0,0 +1,88 @@
+package history
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/ryo246912/gh-actions-dash/internal/atomicfile"
+)
+
+// MaxEntries is the number of search queries kept in the history file
+const MaxEntries = 50
+
+// DefaultPath returns the default search history file path (~/.local/share/gh-actions-dash/search_history.json)
+func DefaultPath() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get home directory: %w", err)
+	}
+	return filepath.Join(home, ".local", "share", "gh-actions-dash", "search_history.json"), nil
+}
+
+// Load reads the search history file at path, most recent query first. A missing file returns an empty history.
+func Load(path string) ([]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read search history file %s: %w", path, err)
+	}
+
+	var entries []string
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("failed to parse search history file %s: %w", path, err)
+	}
+	return entries, nil
+}
+
+// LoadDefault reads the search history file from the default path
+func LoadDefault() ([]string, error) {
+	path, err := DefaultPath()
+	if err != nil {
+		return nil, err
+	}
+	return Load(path)
+}
+
+// Add puts query at the front of entries, removing an older duplicate and keeping at most MaxEntries
+func Add(entries []string, query string) []string {
+	if query == "" {
+		return entries
+	}
+	entries = slices.DeleteFunc(slices.Clone(entries), func(entry string) bool { return entry == query })
+	entries = slices.Insert(entries, 0, query)
+	if len(entries) > MaxEntries {
+		entries = entries[:MaxEntries]
+	}
+	return entries
+}
+
+// Save writes the most recent MaxEntries queries atomically to the search history file at path
+func Save(path string, entries []string) error {
+	if len(entries) > MaxEntries {
+		entries = entries[:MaxEntries]
+	}
+	data, err := json.MarshalIndent(entries, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode search history: %w", err)
+	}
+
+	if err := atomicfile.Write(path, data); err != nil {
+		return fmt.Errorf("failed to write search history file %s: %w", path, err)
+	}
+	return nil
+}
+
+// SaveDefault writes the search history file to the default path
+func SaveDefault(entries []string) error {
+	path, err := DefaultPath()
+	if err != nil {
+		return err
+	}
+	return Save(path, entries)
+}
@@ -1,7 +1,9 @@
 package logs
 
 import (
+	"fmt"
 	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/charmbracelet/lipgloss"
@@ -103,7 +105,8 @@ func (p *Processor) updateStyleFromANSI(style lipgloss.Style, sequence string) l
 	// Split multiple codes
 	parts := strings.Split(codes, ";")
 
-	for _, part := range parts {
+	for i := 0; i < len(parts); i++ {
+		part := parts[i]
 		switch part {
 		case "0": // Reset
 			style = p.baseStyle
@@ -147,12 +150,82 @@ func (p *Processor) updateStyleFromANSI(style lipgloss.Style, sequence string) l
 			style = style.Foreground(lipgloss.Color("#80ffff"))
 		case "97": // Bright White
 			style = style.Foreground(lipgloss.Color("#ffffff"))
+		case "38", "48": // Extended foreground / background (256-color or truecolor)
+			color, consumed, ok := parseExtendedColor(parts[i+1:])
+			i += consumed
+			if !ok {
+				continue
+			}
+			if part == "38" {
+				style = style.Foreground(color)
+			} else {
+				style = style.Background(color)
+			}
 		}
 	}
 
 	return style
 }
 
+// parseExtendedColor parses the parameters following 38 or 48:
+// "5;<n>" for a 256-color index or "2;<r>;<g>;<b>" for truecolor.
+// It returns the color, the number of parameters consumed and whether the color is valid.
+func parseExtendedColor(params []string) (lipgloss.Color, int, bool) {
+	if len(params) == 0 {
+		return "", 0, false
+	}
+
+	switch params[0] {
+	case "5":
+		if len(params) < 2 {
+			return "", len(params), false
+		}
+		n, err := strconv.Atoi(params[1])
+		if err != nil || n < 0 || n > 255 {
+			return "", 2, false
+		}
+		return ansi256Color(n), 2, true
+	case "2":
+		if len(params) < 4 {
+			return "", len(params), false
+		}
+		var rgb [3]int
+		for j := range rgb {
+			v, err := strconv.Atoi(params[1+j])
+			if err != nil || v < 0 || v > 255 {
+				return "", 4, false
+			}
+			rgb[j] = v
+		}
+		return lipgloss.Color(fmt.Sprintf("#%02x%02x%02x", rgb[0], rgb[1], rgb[2])), 4, true
+	default:
+		return "", 1, false
+	}
+}
+
+// ansi16Palette is the xterm palette for the first 16 colors of the 256-color table
+var ansi16Palette = [16]string{
+	"#000000", "#800000", "#008000", "#808000", "#000080", "#800080", "#008080", "#c0c0c0",
+	"#808080", "#ff0000", "#00ff00", "#ffff00", "#0000ff", "#ff00ff", "#00ffff", "#ffffff",
+}
+
+// ansi256Color maps a 256-color index to its hex color in the standard xterm palette
+func ansi256Color(n int) lipgloss.Color {
+	switch {
+	case n < 16:
+		return lipgloss.Color(ansi16Palette[n])
+	case n < 232:
+		// 6x6x6 のカラーキューブ
+		levels := [6]int{0x00, 0x5f, 0x87, 0xaf, 0xd7, 0xff}
+		n -= 16
+		return lipgloss.Color(fmt.Sprintf("#%02x%02x%02x", levels[n/36], levels[(n/6)%6], levels[n%6]))
+	default:
+		// 24段階のグレースケール
+		v := 8 + (n-232)*10
+		return lipgloss.Color(fmt.Sprintf("#%02x%02x%02x", v, v, v))
+	}
+}
+
 // containsANSI checks if a string contains ANSI escape sequences
 func containsANSI(s string) bool {
 	return strings.Contains(s, "\x1b[")
@@ -0,0 +1,64 @@
+package models
+
+import (
+	"math"
+	"sort"
+	"time"
+)
+
+// WorkflowStats represents aggregated statistics of workflow runs
+type WorkflowStats struct {
+	TotalRuns      int
+	SuccessCount   int
+	FailureCount   int
+	CancelledCount int
+	// Durations are computed from the completed runs only
+	AvgDurationSeconds float64
+	P50DurationSeconds float64
+	P95DurationSeconds float64
+	// LastRunAt is when the most recent run was created (zero without runs)
+	LastRunAt time.Time
+}
+
+// ComputeStats computes the statistics of runs. The runs may be in any order.
+func ComputeStats(runs []WorkflowRun) WorkflowStats {
+	stats := WorkflowStats{TotalRuns: len(runs)}
+
+	var durations []float64
+	var total float64
+	for _, run := range runs {
+		if run.CreatedAt.After(stats.LastRunAt) {
+			stats.LastRunAt = run.CreatedAt
+		}
+		if run.Status != "completed" {
+			continue
+		}
+		switch run.Conclusion {
+		case "success":
+			stats.SuccessCount++
+		case "failure":
+			stats.FailureCount++
+		case "cancelled":
+			stats.CancelledCount++
+		}
+		if !run.RunStartedAt.IsZero() && run.UpdatedAt.After(run.RunStartedAt) {
+			seconds := run.UpdatedAt.Sub(run.RunStartedAt).Seconds()
+			durations = append(durations, seconds)
+			total += seconds
+		}
+	}
+
+	if len(durations) > 0 {
+		sort.Float64s(durations)
+		stats.AvgDurationSeconds = total / float64(len(durations))
+		stats.P50DurationSeconds = percentile(durations, 50)
+		stats.P95DurationSeconds = percentile(durations, 95)
+	}
+	return stats
+}
+
+// percentile returns the p-th percentile of sorted values with the nearest-rank method
+func percentile(sorted []float64, p float64) float64 {
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	return sorted[min(max(rank, 1), len(sorted))-1]
+}
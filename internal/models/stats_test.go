@@ -0,0 +1,93 @@
+package models
+
+import (
+	"testing"
+	"time"
+)
+
+func TestComputeStats(t *testing.T) {
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	// run returns a run created at base+offset minutes that took seconds when completed
+	run := func(offset int, status, conclusion string, seconds int) WorkflowRun {
+		createdAt := base.Add(time.Duration(offset) * time.Minute)
+		return WorkflowRun{
+			Status:       status,
+			Conclusion:   conclusion,
+			CreatedAt:    createdAt,
+			RunStartedAt: createdAt,
+			UpdatedAt:    createdAt.Add(time.Duration(seconds) * time.Second),
+		}
+	}
+
+	tests := []struct {
+		name string
+		runs []WorkflowRun
+		want WorkflowStats
+	}{
+		{
+			name: "no runs",
+			want: WorkflowStats{},
+		},
+		{
+			name: "one run",
+			runs: []WorkflowRun{run(0, "completed", "success", 60)},
+			want: WorkflowStats{
+				TotalRuns: 1, SuccessCount: 1,
+				AvgDurationSeconds: 60, P50DurationSeconds: 60, P95DurationSeconds: 60,
+				LastRunAt: base,
+			},
+		},
+		{
+			name: "runs not completed are counted but have no duration",
+			runs: []WorkflowRun{run(0, "in_progress", "", 60), run(5, "queued", "", 0)},
+			want: WorkflowStats{TotalRuns: 2, LastRunAt: base.Add(5 * time.Minute)},
+		},
+		{
+			name: "percentile boundaries",
+			// 20件なら p50 は10番目、p95 は19番目(nearest-rank)
+			runs: func() []WorkflowRun {
+				var runs []WorkflowRun
+				for i := 20; i >= 1; i-- {
+					runs = append(runs, run(i, "completed", "failure", i*10))
+				}
+				return runs
+			}(),
+			want: WorkflowStats{
+				TotalRuns: 20, FailureCount: 20,
+				AvgDurationSeconds: 105, P50DurationSeconds: 100, P95DurationSeconds: 190,
+				LastRunAt: base.Add(20 * time.Minute),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ComputeStats(tt.runs); got != tt.want {
+				t.Errorf("ComputeStats() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPercentile(t *testing.T) {
+	tests := []struct {
+		sorted []float64
+		p      float64
+		want   float64
+	}{
+		{[]float64{7}, 50, 7},
+		{[]float64{7}, 95, 7},
+		{[]float64{1, 2}, 50, 1},
+		{[]float64{1, 2}, 51, 2},
+		{[]float64{1, 2, 3, 4}, 0, 1},
+		{[]float64{1, 2, 3, 4}, 100, 4},
+		{[]float64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20}, 95, 19},
+		{[]float64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21}, 95, 20},
+	}
+
+	for _, tt := range tests {
+		if got := percentile(tt.sorted, tt.p); got != tt.want {
+			t.Errorf("percentile(%v, %v) = %v, want %v", tt.sorted, tt.p, got, tt.want)
+		}
+	}
+}
@@ -42,6 +42,53 @@ type WorkflowRun struct {
 	HeadCommit   Commit        `json:"head_commit"`
 	Repository   Repository    `json:"repository"`
 	PullRequests []PullRequest `json:"pull_requests"`
+
+	// HeadRepository is the repository of the head commit, which is the fork for runs of fork PRs
+	HeadRepository Repository `json:"head_repository"`
+
+	// TriggeringActor is who triggered the latest attempt (e.g. a re-run), while Actor initiated the original run
+	TriggeringActor Actor `json:"triggering_actor"`
+
+	// PendingDeployments is fetched separately for runs waiting for approval
+	PendingDeployments []PendingDeployment `json:"-"`
+	// Usage is fetched separately and holds the billable time per OS
+	Usage *WorkflowRunUsage `json:"-"`
+}
+
+// PendingDeployment represents a deployment waiting for environment protection rule approval
+type PendingDeployment struct {
+	Environment           Environment          `json:"environment"`
+	WaitTimer             int                  `json:"wait_timer"`
+	CurrentUserCanApprove bool                 `json:"current_user_can_approve"`
+	Reviewers             []DeploymentReviewer `json:"reviewers"`
+}
+
+// Environment represents a deployment environment
+type Environment struct {
+	ID      int64  `json:"id"`
+	Name    string `json:"name"`
+	HTMLURL string `json:"html_url"`
+}
+
+// DeploymentReviewer represents a user or team required to review a deployment
+type DeploymentReviewer struct {
+	Type     string `json:"type"`
+	Reviewer struct {
+		Login string `json:"login"`
+		Name  string `json:"name"`
+		Slug  string `json:"slug"`
+	} `json:"reviewer"`
+}
+
+// DisplayName returns the login of a user reviewer or the name of a team reviewer
+func (r DeploymentReviewer) DisplayName() string {
+	if r.Reviewer.Login != "" {
+		return r.Reviewer.Login
+	}
+	if r.Reviewer.Slug != "" {
+		return r.Reviewer.Slug
+	}
+	return r.Reviewer.Name
 }
 
 // Job represents a job in a workflow run
@@ -61,6 +108,7 @@ type Job struct {
 	CompletedAt time.Time `json:"completed_at"`
 	Name        string    `json:"name"`
 	Steps       []Step    `json:"steps"`
+	Labels      []string  `json:"labels"`
 }
 
 // Step represents a step in a job
@@ -101,14 +149,15 @@ type Author struct {
 
 // Repository represents a GitHub repository
 type Repository struct {
-	ID       int64  `json:"id"`
-	NodeID   string `json:"node_id"`
-	Name     string `json:"name"`
-	FullName string `json:"full_name"`
-	Owner    Actor  `json:"owner"`
-	Private  bool   `json:"private"`
-	HTMLURL  string `json:"html_url"`
-	URL      string `json:"url"`
+	ID            int64  `json:"id"`
+	NodeID        string `json:"node_id"`
+	Name          string `json:"name"`
+	FullName      string `json:"full_name"`
+	Owner         Actor  `json:"owner"`
+	Private       bool   `json:"private"`
+	HTMLURL       string `json:"html_url"`
+	URL           string `json:"url"`
+	DefaultBranch string `json:"default_branch"`
 }
 
 // PullRequest represents a GitHub pull request
@@ -121,9 +170,89 @@ type PullRequest struct {
 	Head    struct {
 		Ref string `json:"ref"`
 		Sha string `json:"sha"`
+		// Repo is nil when the head repository (e.g. a fork) has been deleted
+		Repo *Repository `json:"repo"`
 	} `json:"head"`
 	Base struct {
 		Ref string `json:"ref"`
 		Sha string `json:"sha"`
 	} `json:"base"`
 }
+
+// WorkflowDispatchInput represents an input of a workflow_dispatch trigger
+type WorkflowDispatchInput struct {
+	Name        string
+	Description string
+	Type        string
+	Default     string
+	Required    bool
+	Options     []string
+}
+
+// RunLogs represents the combined logs of a workflow run
+type RunLogs struct {
+	Content  string
+	Sections []LogSection
+}
+
+// LogSection represents the range of combined log lines extracted from a single log file
+type LogSection struct {
+	FileName  string
+	StartLine int // index of the "=== <filename> ===" header line
+	EndLine   int // exclusive
+}
+
+// WorkflowRunUsage represents the billable time of a workflow run
+type WorkflowRunUsage struct {
+	// Billable maps an OS (UBUNTU, MACOS, WINDOWS) to its billable time
+	Billable      map[string]BillableUsage `json:"billable"`
+	RunDurationMs int                      `json:"run_duration_ms"`
+}
+
+// BillableUsage represents the billable time of the jobs of a workflow run on one OS
+type BillableUsage struct {
+	TotalMs int `json:"total_ms"`
+	Jobs    int `json:"jobs"`
+}
+
+// TotalMs returns the billable time of the run on every OS
+func (u *WorkflowRunUsage) TotalMs() int {
+	total := 0
+	for _, usage := range u.Billable {
+		total += usage.TotalMs
+	}
+	return total
+}
+
+// Artifact represents an artifact uploaded by a workflow run
+type Artifact struct {
+	ID                 int64     `json:"id"`
+	Name               string    `json:"name"`
+	SizeInBytes        int64     `json:"size_in_bytes"`
+	Expired            bool      `json:"expired"`
+	CreatedAt          time.Time `json:"created_at"`
+	ExpiresAt          time.Time `json:"expires_at"`
+	ArchiveDownloadURL string    `json:"archive_download_url"`
+}
+
+// ActionsCacheEntry represents a GitHub Actions cache of a repository
+type ActionsCacheEntry struct {
+	ID             int64     `json:"id"`
+	Ref            string    `json:"ref"`
+	Key            string    `json:"key"`
+	Version        string    `json:"version"`
+	SizeInBytes    int64     `json:"size_in_bytes"`
+	LastAccessedAt time.Time `json:"last_accessed_at"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+// Annotation represents a check run annotation (an error or warning reported by a step)
+type Annotation struct {
+	Path            string `json:"path"`
+	StartLine       int    `json:"start_line"`
+	EndLine         int    `json:"end_line"`
+	AnnotationLevel string `json:"annotation_level"` // notice, warning or failure
+	Title           string `json:"title"`
+	Message         string `json:"message"`
+	RawDetails      string `json:"raw_details"`
+}
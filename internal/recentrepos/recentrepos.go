@@ -0,0 +1,89 @@
+package recentrepos
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/ryo246912/gh-actions-dash/internal/atomicfile"
+)
+
+// MaxEntries is the number of repositories kept in the recent repositories file
+const MaxEntries = 20
+
+// DefaultPath returns the default recent repositories file path (~/.local/share/gh-actions-dash/recent_repos.json)
+func DefaultPath() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get home directory: %w", err)
+	}
+	return filepath.Join(home, ".local", "share", "gh-actions-dash", "recent_repos.json"), nil
+}
+
+// Load reads the recent repositories file at path as "owner/repo" entries, most recent first.
+// A missing file returns an empty list.
+func Load(path string) ([]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read recent repositories file %s: %w", path, err)
+	}
+
+	var entries []string
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("failed to parse recent repositories file %s: %w", path, err)
+	}
+	return entries, nil
+}
+
+// LoadDefault reads the recent repositories file from the default path
+func LoadDefault() ([]string, error) {
+	path, err := DefaultPath()
+	if err != nil {
+		return nil, err
+	}
+	return Load(path)
+}
+
+// Add puts repo ("owner/repo") at the front of entries, removing an older duplicate and keeping at most MaxEntries
+func Add(entries []string, repo string) []string {
+	if repo == "" {
+		return entries
+	}
+	entries = slices.DeleteFunc(slices.Clone(entries), func(entry string) bool { return entry == repo })
+	entries = slices.Insert(entries, 0, repo)
+	if len(entries) > MaxEntries {
+		entries = entries[:MaxEntries]
+	}
+	return entries
+}
+
+// Save writes the most recent MaxEntries repositories atomically to the recent repositories file at path
+func Save(path string, entries []string) error {
+	if len(entries) > MaxEntries {
+		entries = entries[:MaxEntries]
+	}
+	data, err := json.MarshalIndent(entries, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode recent repositories: %w", err)
+	}
+
+	if err := atomicfile.Write(path, data); err != nil {
+		return fmt.Errorf("failed to write recent repositories file %s: %w", path, err)
+	}
+	return nil
+}
+
+// SaveDefault writes the recent repositories file to the default path
+func SaveDefault(entries []string) error {
+	path, err := DefaultPath()
+	if err != nil {
+		return err
+	}
+	return Save(path, entries)
+}
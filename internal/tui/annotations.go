@@ -0,0 +1,163 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/ryo246912/gh-actions-dash/internal/github"
+	"github.com/ryo246912/gh-actions-dash/internal/models"
+)
+
+// annotationDetailMaxLines limits the message lines shown in the annotation detail
+const annotationDetailMaxLines = 8
+
+type annotationsLoadedMsg struct {
+	runID       int64
+	annotations []models.Annotation
+}
+
+// loadRunAnnotations fetches the check run annotations of every job of the run
+func (a *App) loadRunAnnotations(runID int64) tea.Cmd {
+	return tea.Cmd(func() tea.Msg {
+		jobs, found := a.jobsCache.Get(runID)
+		if !found {
+			var err error
+			if jobs, err = a.client.GetWorkflowRunJobs(a.owner, a.repo, runID, github.JobsFilterLatest); err != nil {
+				// アノテーションは補助情報なので取得できなくてもエラー表示しない
+				return annotationsLoadedMsg{runID: runID}
+			}
+		}
+
+		var annotations []models.Annotation
+		for _, job := range jobs {
+			// ジョブIDはチェックランIDと同じ
+			jobAnnotations, err := a.client.GetCheckRunAnnotations(a.owner, a.repo, job.ID)
+			if err != nil {
+				continue
+			}
+			annotations = append(annotations, jobAnnotations...)
+		}
+		return annotationsLoadedMsg{runID: runID, annotations: annotations}
+	})
+}
+
+// handleAnnotationsLoaded stores the annotations of the current run and maps them to log lines
+func (a *App) handleAnnotationsLoaded(msg annotationsLoadedMsg) (tea.Model, tea.Cmd) {
+	if a.currentRun == nil || a.currentRun.ID != msg.runID {
+		return a, nil
+	}
+	a.runAnnotations = msg.annotations
+	a.mapLogAnnotations()
+	return a, nil
+}
+
+// mapLogAnnotations maps the annotations of the current run to the lines of the shown log
+func (a *App) mapLogAnnotations() {
+	a.logAnnotations = mapAnnotationsToLines(strings.Split(a.logs, "\n"), a.runAnnotations)
+}
+
+// mapAnnotationsToLines finds the log line of each error and warning annotation by its title or message.
+// Lines with a ##[error] or ##[warning] command are preferred over other mentions of the same text.
+func mapAnnotationsToLines(lines []string, annotations []models.Annotation) map[int]models.Annotation {
+	mapped := make(map[int]models.Annotation)
+	for _, annotation := range annotations {
+		if annotation.AnnotationLevel != "failure" && annotation.AnnotationLevel != "warning" {
+			continue
+		}
+		message, _, _ := strings.Cut(strings.TrimSpace(annotation.Message), "\n")
+		for _, needle := range []string{strings.TrimSpace(annotation.Title), message} {
+			if needle == "" {
+				continue
+			}
+			if line := findAnnotationLine(lines, needle, mapped); line >= 0 {
+				mapped[line] = annotation
+				break
+			}
+		}
+	}
+	return mapped
+}
+
+// findAnnotationLine returns the index of the line mentioning needle that is not annotated yet, or -1
+func findAnnotationLine(lines []string, needle string, mapped map[int]models.Annotation) int {
+	found := -1
+	for i, line := range lines {
+		if _, taken := mapped[i]; taken || !strings.Contains(line, needle) {
+			continue
+		}
+		if strings.Contains(line, "##[error]") || strings.Contains(line, "##[warning]") {
+			return i
+		}
+		if found < 0 {
+			found = i
+		}
+	}
+	return found
+}
+
+// annotationGutter returns the gutter marker of a log line, or blanks when it has no annotation
+func (a *App) annotationGutter(lineIndex int) string {
+	annotation, ok := a.logAnnotations[lineIndex]
+	if !ok {
+		return "  "
+	}
+	if annotation.AnnotationLevel == "failure" {
+		return a.styles.StatusFailure.Render("✖") + " "
+	}
+	return a.styles.StatusPending.Render("⚠") + " "
+}
+
+// annotationAtCursor returns the annotation of the jumped-to line if it is visible, otherwise of the top line
+func (a *App) annotationAtCursor(viewHeight int) (models.Annotation, bool) {
+	if a.logCursorLine >= 0 {
+		row := a.logRowOfLine(a.logCursorLine)
+		if row >= a.logOffset && row < a.logOffset+viewHeight && a.logLineAtRow(row) == a.logCursorLine {
+			if annotation, ok := a.logAnnotations[a.logCursorLine]; ok {
+				return annotation, true
+			}
+		}
+	}
+	if a.logOffset >= a.logDisplayCount(strings.Count(a.logs, "\n")+1) {
+		return models.Annotation{}, false
+	}
+	annotation, ok := a.logAnnotations[a.logLineAtRow(a.logOffset)]
+	return annotation, ok
+}
+
+// handleAnnotationDetailInput closes the annotation detail
+func (a *App) handleAnnotationDetailInput(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if key.Matches(msg, a.keyMap.Back, a.keyMap.Enter, a.keyMap.Quit) {
+		a.annotationDetail = nil
+	}
+	return a, nil
+}
+
+// renderAnnotationDetail renders the full message of the selected annotation
+func (a *App) renderAnnotationDetail() string {
+	annotation := a.annotationDetail
+	title := annotation.Title
+	if title == "" {
+		title = strings.ToUpper(annotation.AnnotationLevel[:1]) + annotation.AnnotationLevel[1:]
+	}
+	style := a.styles.StatusPending
+	if annotation.AnnotationLevel == "failure" {
+		style = a.styles.StatusFailure
+	}
+
+	lines := []string{style.Render(title)}
+	if annotation.Path != "" {
+		lines = append(lines, a.styles.HelpDesc.Render(fmt.Sprintf("%s:%d", annotation.Path, annotation.StartLine)))
+	}
+	message := strings.Split(strings.TrimSpace(annotation.Message), "\n")
+	if len(message) > annotationDetailMaxLines {
+		message = append(message[:annotationDetailMaxLines], "...")
+	}
+	for _, line := range message {
+		lines = append(lines, a.styles.HelpDesc.Render(line))
+	}
+	lines = append(lines, a.styles.HelpDesc.Render("Enter/Esc: Close"))
+	return lipgloss.JoinVertical(lipgloss.Left, lines...)
+}
@@ -1,11 +1,18 @@
 package tui
 
 import (
+	"errors"
 	"fmt"
+	"io"
+	"log/slog"
+	"os"
 	"regexp"
+	"slices"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/charmbracelet/bubbles/help"
@@ -13,7 +20,12 @@ import (
 	"github.com/charmbracelet/bubbles/list"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/ryo246912/gh-actions-dash/internal/bookmarks"
+	"github.com/ryo246912/gh-actions-dash/internal/browser"
+	"github.com/ryo246912/gh-actions-dash/internal/config"
+	"github.com/ryo246912/gh-actions-dash/internal/diff"
 	"github.com/ryo246912/gh-actions-dash/internal/github"
+	"github.com/ryo246912/gh-actions-dash/internal/history"
 	"github.com/ryo246912/gh-actions-dash/internal/logs"
 	"github.com/ryo246912/gh-actions-dash/internal/models"
 	"github.com/ryo246912/gh-actions-dash/internal/tui/components"
@@ -27,78 +39,274 @@ const (
 	WorkflowListView
 	WorkflowRunsView
 	WorkflowRunLogsView
+	BranchRunsView
+	ArtifactsView
+	PRRunsView
+	DebugLogView
+	CachesView
 )
 
-// JobsCacheEntry represents a cached job entry with timestamp
+// String returns the name of the view state used in logs
+func (v ViewState) String() string {
+	switch v {
+	case AllRunsView:
+		return "AllRunsView"
+	case WorkflowListView:
+		return "WorkflowListView"
+	case WorkflowRunsView:
+		return "WorkflowRunsView"
+	case WorkflowRunLogsView:
+		return "WorkflowRunLogsView"
+	case BranchRunsView:
+		return "BranchRunsView"
+	case ArtifactsView:
+		return "ArtifactsView"
+	case PRRunsView:
+		return "PRRunsView"
+	case DebugLogView:
+		return "DebugLogView"
+	case CachesView:
+		return "CachesView"
+	}
+	return fmt.Sprintf("ViewState(%d)", int(v))
+}
+
+// JobsCacheEntry represents a cached job entry with timestamp and its own TTL
 type JobsCacheEntry struct {
 	Jobs      []models.Job
 	Timestamp time.Time
+	TTL       time.Duration
 }
 
-// JobsCache represents the jobs cache with TTL
+// defaultJobsCacheMaxEntries is the default number of runs kept in the jobs cache
+const defaultJobsCacheMaxEntries = 200
+
+const (
+	// completedJobsCacheTTL is how long the jobs of a completed run are cached
+	completedJobsCacheTTL = 10 * time.Minute
+	// activeJobsCacheTTL is how long the jobs of a queued or in-progress run are cached
+	activeJobsCacheTTL = 15 * time.Second
+)
+
+// JobsCache represents the jobs cache with TTL and a size limit.
+// Entries are kept in a sync.Map of atomic pointers so that concurrent loads of different runs
+// do not contend on a single lock.
 type JobsCache struct {
-	mu      sync.RWMutex
-	entries map[int64]JobsCacheEntry
-	ttl     time.Duration
+	entries    sync.Map // int64 -> *atomic.Pointer[JobsCacheEntry]
+	size       atomic.Int64
+	evictMu    sync.Mutex // serializes evictions
+	ttl        time.Duration
+	maxEntries int
 }
 
-// NewJobsCache creates a new jobs cache
+// NewJobsCache creates a new jobs cache holding up to defaultJobsCacheMaxEntries runs
 func NewJobsCache(ttl time.Duration) *JobsCache {
+	return NewJobsCacheWithOptions(ttl, defaultJobsCacheMaxEntries)
+}
+
+// NewJobsCacheWithOptions creates a new jobs cache that evicts the oldest entry
+// once maxEntries runs are cached (0 or less means no limit)
+func NewJobsCacheWithOptions(ttl time.Duration, maxEntries int) *JobsCache {
 	return &JobsCache{
-		entries: make(map[int64]JobsCacheEntry),
-		ttl:     ttl,
+		ttl:        ttl,
+		maxEntries: maxEntries,
+	}
+}
+
+// load returns the current entry of a run
+func (c *JobsCache) load(runID int64) (*JobsCacheEntry, bool) {
+	value, exists := c.entries.Load(runID)
+	if !exists {
+		return nil, false
 	}
+	entry := value.(*atomic.Pointer[JobsCacheEntry]).Load()
+	return entry, entry != nil
 }
 
 // Get retrieves jobs from cache if not expired
 func (c *JobsCache) Get(runID int64) ([]models.Job, bool) {
-	c.mu.RLock()
-	defer c.mu.RUnlock()
-
-	entry, exists := c.entries[runID]
+	entry, exists := c.load(runID)
 	if !exists {
 		return nil, false
 	}
 
-	if time.Since(entry.Timestamp) > c.ttl {
+	if time.Since(entry.Timestamp) > c.entryTTL(*entry) {
 		return nil, false
 	}
 
 	return entry.Jobs, true
 }
 
-// Set stores jobs in cache with current timestamp
-func (c *JobsCache) Set(runID int64, jobs []models.Job) {
-	c.mu.Lock()
-	defer c.mu.Unlock()
+// entryTTL returns the TTL of an entry, falling back to the cache TTL
+func (c *JobsCache) entryTTL(entry JobsCacheEntry) time.Duration {
+	if entry.TTL > 0 {
+		return entry.TTL
+	}
+	return c.ttl
+}
 
-	c.entries[runID] = JobsCacheEntry{
+// Set stores jobs in cache with current timestamp.
+// A ttl of 0 or less uses the TTL of the cache.
+func (c *JobsCache) Set(runID int64, jobs []models.Job, ttl time.Duration) {
+	entry := &JobsCacheEntry{
 		Jobs:      jobs,
 		Timestamp: time.Now(),
+		TTL:       ttl,
+	}
+
+	pointer := new(atomic.Pointer[JobsCacheEntry])
+	pointer.Store(entry)
+	for {
+		value, loaded := c.entries.LoadOrStore(runID, pointer)
+		if !loaded {
+			break
+		}
+
+		// 既存のエントリはポインタの差し替えだけで更新する
+		existing := value.(*atomic.Pointer[JobsCacheEntry])
+		current := existing.Load()
+		if current != nil && existing.CompareAndSwap(current, entry) {
+			return
+		}
+		if current == nil {
+			// 削除中のポインタはマップから外してから作り直す
+			c.entries.CompareAndDelete(runID, existing)
+		}
+	}
+
+	// 上限を超えたら最も古いエントリを削除する
+	if size := c.size.Add(1); c.maxEntries > 0 && size > int64(c.maxEntries) {
+		c.evictOldest()
+	}
+}
+
+// evictOldest removes the entry with the oldest timestamp
+func (c *JobsCache) evictOldest() {
+	c.evictMu.Lock()
+	defer c.evictMu.Unlock()
+
+	var oldestID int64
+	var oldestPointer *atomic.Pointer[JobsCacheEntry]
+	var oldest *JobsCacheEntry
+	c.entries.Range(func(key, value any) bool {
+		pointer := value.(*atomic.Pointer[JobsCacheEntry])
+		entry := pointer.Load()
+		if entry != nil && (oldest == nil || entry.Timestamp.Before(oldest.Timestamp)) {
+			oldestID = key.(int64)
+			oldestPointer = pointer
+			oldest = entry
+		}
+		return true
+	})
+	if oldest != nil {
+		c.remove(oldestID, oldestPointer, oldest)
+	}
+}
+
+// Delete removes the jobs of a run from the cache
+func (c *JobsCache) Delete(runID int64) {
+	value, exists := c.entries.Load(runID)
+	if !exists {
+		return
+	}
+	c.remove(runID, value.(*atomic.Pointer[JobsCacheEntry]), nil)
+}
+
+// remove clears the pointer of a run and drops it from the map.
+// When entry is not nil the pointer is only cleared while it still holds that entry,
+// so an entry stored by a concurrent Set is never removed.
+func (c *JobsCache) remove(runID int64, pointer *atomic.Pointer[JobsCacheEntry], entry *JobsCacheEntry) {
+	var removed bool
+	if entry == nil {
+		removed = pointer.Swap(nil) != nil
+	} else {
+		removed = pointer.CompareAndSwap(entry, nil)
+	}
+	if !removed {
+		return
 	}
+	// nilになったポインタはSetで再利用されないので安全に外せる
+	c.entries.CompareAndDelete(runID, pointer)
+	c.size.Add(-1)
+}
+
+// Clear removes every entry
+func (c *JobsCache) Clear() {
+	c.entries.Range(func(key, value any) bool {
+		c.remove(key.(int64), value.(*atomic.Pointer[JobsCacheEntry]), nil)
+		return true
+	})
 }
 
 // Cleanup removes expired entries
 func (c *JobsCache) Cleanup() {
-	c.mu.Lock()
-	defer c.mu.Unlock()
-
 	now := time.Now()
-	for runID, entry := range c.entries {
-		if now.Sub(entry.Timestamp) > c.ttl {
-			delete(c.entries, runID)
+	c.entries.Range(func(key, value any) bool {
+		pointer := value.(*atomic.Pointer[JobsCacheEntry])
+		entry := pointer.Load()
+		if entry != nil && now.Sub(entry.Timestamp) > c.entryTTL(*entry) {
+			c.remove(key.(int64), pointer, entry)
 		}
-	}
+		return true
+	})
+}
+
+// defaultLogsCacheTTL is how long the logs of completed runs are cached
+const defaultLogsCacheTTL = 30 * time.Minute
+
+// defaultArtifactsCacheTTL is how long the artifact metadata of a run is cached
+const defaultArtifactsCacheTTL = 30 * time.Minute
+
+// Options represents startup options for the application
+type Options struct {
+	// RefreshInterval is the auto-refresh interval (0 disables auto-refresh)
+	RefreshInterval time.Duration
+	// AbsoluteTime shows absolute timestamps instead of relative ones in run lists
+	AbsoluteTime bool
+	// PerPage is the number of workflows and runs fetched per page (0 uses the default)
+	PerPage int
+	// Created limits the all runs view to runs created within the range
+	Created github.TimeRange
+	// Branch limits the all runs view to the runs of a branch (empty shows every branch)
+	Branch string
+	// ListDensity is the initial density of run lists (config.ListDensity* names)
+	ListDensity string
+	// Columns is the ordered list of run list columns (config.Column* names, empty shows all columns)
+	Columns []string
+	// StaleRunThreshold is how long an in-progress run may go without updates before it is flagged as stuck (0 uses the default)
+	StaleRunThreshold time.Duration
+	// Bookmarks stores the bookmarked log lines (nil disables bookmarks)
+	Bookmarks *bookmarks.Store
+	// NoColor strips all ANSI styling from the rendered output
+	NoColor bool
+	// SearchHistory is the log search history, most recent query first
+	SearchHistory []string
+	// RecentRepos is the recently visited repositories ("owner/repo"), most recent first
+	RecentRepos []string
+	// Watch polls the runs and follows the latest in-progress run until every run has completed
+	Watch bool
+	// Logger receives the view transitions (nil discards them)
+	Logger *slog.Logger
 }
 
+// defaultPerPage is the page size used when Options.PerPage is not set
+const defaultPerPage = 30
+
 // App represents the main application state
 type App struct {
 	// 検索機能
-	searchInputMode    bool
-	searchInputBuffer  string
-	searchActiveQuery  string // 検索確定後もハイライト用
-	searchMatchIndices []int  // 検索ヒット行番号リスト
-	searchMatchIndex   int    // 現在のヒットインデックス
+	searchInputMode       bool
+	searchInputBuffer     string
+	searchActiveQuery     string   // 検索確定後もハイライト用
+	searchMatchIndices    []int    // 検索ヒット行番号リスト
+	searchInvert          bool     // trueなら一致しなかった行だけを表示する
+	searchNonMatchIndices []int    // 反転検索中に表示する行番号リスト
+	searchMatchIndex      int      // 現在のヒットインデックス
+	searchRegexMode       bool     // trueなら正規表現で検索
+	searchCaseSensitive   bool     // trueなら大文字小文字を区別する
+	searchContextLines    int      // 検索ヒット行の前後に表示するコンテキスト行数
+	searchHistory         []string // 検索履歴(新しい順)
+	searchHistoryIndex    int      // 履歴を辿っている位置(-1は未選択)
 
 	// workflow file閲覧モード
 	viewingWorkflowFile bool
@@ -106,15 +314,24 @@ type App struct {
 	workflowFilePath    string
 	workflowFileRef     string // 取得したref(短縮表示用にも利用)
 	workflowFileLoading bool
-	workflowFileOffset  int               // スクロール位置
-	workflowFileCache   map[string]string // key: path@ref -> content
+	workflowFileOffset  int                 // スクロール位置
+	workflowFileCache   map[string]string   // key: path@ref -> content
+	workflowFileErrors  []workflowFileError // YAMLのパースエラー
+	workflowFileDiff    []diff.Line         // nil以外なら差分を表示する
 	client              *github.Client
 	owner               string
 	repo                string
+	logger              *slog.Logger
+
+	// Workflow file diff input(2つのrefの入力)
+	diffInputMode   bool
+	diffInputBuffer string
+	diffBaseRef     string
+	diffWorkflow    models.Workflow
 
 	// UI state
 	viewState ViewState
-	keyMap    KeyMap
+	keyMap    config.KeyMap
 	styles    Styles
 	help      help.Model
 
@@ -126,7 +343,8 @@ type App struct {
 	currentRun      *models.WorkflowRun
 	currentJobs     []models.Job
 	logs            string
-	logsCache       map[int64]string // runID -> logs (session cache)
+	logSections     []models.LogSection // source file of each range of log lines
+	logsCache       *ttlCache[int64, *models.RunLogs]
 
 	// Lists
 	workflowList list.Model
@@ -140,7 +358,13 @@ type App struct {
 	logProcessor *logs.Processor
 
 	// Scrollable content
-	logOffset int
+	logOffset     int
+	logCursorLine int // 行ジャンプ・検索で移動した行(-1は未設定)
+
+	// Check run annotations(ログのガターに表示するエラー・警告)
+	runAnnotations   []models.Annotation
+	logAnnotations   map[int]models.Annotation // 行番号→アノテーション
+	annotationDetail *models.Annotation        // Enterで表示中のアノテーション
 
 	// Dimensions
 	width  int
@@ -158,24 +382,278 @@ type App struct {
 	allRunsPerPage   int
 	allRunsTotal     int
 
+	workflowRunsPage    int
+	workflowRunsPerPage int
+	workflowRunsTotal   int
+
 	// Cache and debounce
 	jobsCache     *JobsCache
+	billingCache  *ttlCache[int64, *models.WorkflowRunUsage]
 	debounceTimer *time.Timer
 	pendingRunID  int64
+	pendingRepo   string // 読み込み予約時の owner/repo
 	debounceMutex sync.Mutex
 
+	// Pending deployments of runs waiting for approval(承認待ちのデプロイ)
+	pendingDeployments      map[int64][]models.PendingDeployment
+	pendingDeploymentsMutex sync.RWMutex
+
 	// Log jump input mode(行ジャンプ入力モード)
 	jumpInputMode   bool
 	jumpInputBuffer string
+
+	// Log save input mode(ログ保存先入力モード)
+	saveInputMode   bool
+	saveInputBuffer string
+
+	// Disable ANSI styling(--no-color)
+	noColor bool
+
+	// Log bookmarks(ログ行のブックマーク)
+	bookmarks     *bookmarks.Store
+	bookmarkMode  bool
+	bookmarkIndex int
+
+	// Collapsible step sections(ステップごとの折りたたみ)
+	stepSections    []StepLogSection
+	logDisplayLines []int // 表示する行のインデックス(nilなら全行)
+
+	// Job selector sidebar(ログビューのジョブ選択サイドバー)
+	showJobSidebar    bool
+	jobSidebarIndex   int
+	expandedJobGroups map[string]bool // マトリックスジョブの展開状態
+
+	// Single job log(ジョブ単位のログ表示)
+	jobLog       *models.Job // 表示中のジョブ(nilならラン全体のログ)
+	jobLogsCache map[int64]string
+
+	// Status flash message(一定時間だけ表示するメッセージ)
+	statusMessage   string
+	statusMessageID int
+
+	// Auto-refresh
+	refreshInterval time.Duration
+
+	// Creation time range of the all runs view(--since/--until)
+	created github.TimeRange
+
+	// Branch of the all runs view(--branch、ブランチ入力でEscを押すと解除)
+	defaultBranchFilter string
+
+	// Help overlay
+	showHelp bool
+
+	// Artifacts view(ランのアーティファクト一覧)
+	artifacts        []models.Artifact
+	artifactIndex    int
+	artifactsLoading bool
+	artifactsCache   *ttlCache[int64, []models.Artifact]
+
+	// Artifact download progress(ダウンロード中のアーティファクトと保存済みバイト数)
+	artifactDownloading   *models.Artifact
+	artifactDownloadBytes atomic.Int64
+
+	// Actions caches(キャッシュ使用量と一覧)
+	cacheUsage          *components.CacheUsage
+	cacheUsageRequested bool
+	caches              []models.ActionsCacheEntry
+	cacheIndex          int
+	cachesLoading       bool
+	cacheDeleteConfirm  bool
+
+	// Watch mode(実行中のランを自動で追跡する)
+	watch   bool
+	logTail bool // ログを末尾に追従させる
+
+	// Token info panel(トークンのスコープ診断)
+	showInfo    bool
+	infoLoading bool
+	infoUser    string
+	infoScopes  []string
+	infoErr     error
+
+	// Run metadata box(ログ表示中に右上に重ねるランの情報)
+	showRunMeta bool
+
+	// Run graph overlay(再利用ワークフローの呼び出し関係)
+	showRunGraph    bool
+	runGraphRun     models.WorkflowRun
+	runGraphRuns    []models.WorkflowRun
+	runGraphLoading bool
+	runGraphErr     error
+
+	// Run attempts overlay(再実行されたランの各アテンプトとそのジョブ)
+	showRunAttempts    bool
+	runAttemptsRun     models.WorkflowRun
+	runAttempts        []models.WorkflowRun
+	runAttemptJobs     []models.Job
+	runAttemptsLoading bool
+	runAttemptsErr     error
+
+	// Workflow env overlay(ログ表示中のランのワークフローのenv:の変数名)
+	showWorkflowEnv    bool
+	workflowEnvRun     models.WorkflowRun
+	workflowEnvContent string
+	workflowEnvLoading bool
+	workflowEnvErr     error
+
+	// Repository switcher(最近開いたリポジトリへの切り替え)
+	showRepoSwitcher  bool
+	recentRepos       []string
+	repoSwitcherIndex int
+
+	// Branch filter view(ブランチ絞り込み)
+	branchInputMode   bool
+	branchInputBuffer string
+	branchFilter      string
+	branchRuns        []models.WorkflowRun
+	branchRunsList    list.Model
+	branchRunsPage    int
+	branchRunsTotal   int
+
+	// Pull request view(PR番号絞り込み)
+	prInputMode   bool
+	prInputBuffer string
+	prNumber      int
+	prRuns        []models.WorkflowRun
+	prRunsList    list.Model
+	prRunsPage    int
+	prRunsTotal   int
+
+	// View to return to when leaving the logs view
+	logsParentView ViewState
+
+	// Fuzzy list filter(一覧の絞り込み)
+	listFilterInputMode bool
+	workflowFilter      listFilter
+	allRunsFilter       listFilter
+
+	// workflow_dispatch form(手動実行フォーム)
+	dispatchInputMode  bool
+	dispatchWorkflow   models.Workflow
+	dispatchFields     []dispatchField
+	dispatchFieldIndex int
+
+	// Batch selection of runs(複数選択による一括操作)
+	selectedRuns map[int64]bool
+	runDelegate  *components.WorkflowRunItemDelegate
+
+	// List density(0=compact, 1=comfortable, 2=spacious)
+	listDensity int
+
+	// Sort key of the run lists(runSort* のいずれか、time はAPIの順序)
+	sortKey string
+
+	// Preview panel visibility(狭い端末ではリストを全幅で表示する)
+	previewVisible bool
+
+	// Blinking marker of in-progress runs(実行中ランの点滅表示)
+	blinkScheduled bool
+
+	// Background page loading(ページ送り中も一覧を操作できるようにする)
+	paginationInProgress     bool
+	paginationLoadingSpinner components.LoadingSpinner
+
+	// Jobs preview loading during the debounce period(デバウンス中は古いジョブを表示しない)
+	jobsLoading        bool // guarded by debounceMutex
+	jobsSpinnerRunning bool
+	jobsLoadingSpinner components.LoadingSpinner
+
+	// Mouse support(クリックによる選択・ダブルクリック判定)
+	workflowDelegate *components.WorkflowItemDelegate
+	lastClickIndex   int
+	lastClickTime    time.Time
+
+	// Event type filter of the all runs view(イベント種別での絞り込み)
+	eventPickerMode    bool
+	eventPickerOptions []string
+	eventPickerIndex   int
+	eventFilter        string
+
+	// Commit message search of the all runs view(コミットメッセージ検索)
+	commitSearchInputMode bool
+	commitSearchBuffer    string
+	commitSearchQuery     string
+
+	// Actor filter of the run lists(実行者での絞り込み)
+	actorInputMode   bool
+	actorInputBuffer string
+	actorFilter      string
+
+	// Pending deployment approval dialog(承認ダイアログ)
+	approvalMode          bool
+	approvalRun           models.WorkflowRun
+	approvalDeployments   []models.PendingDeployment
+	approvalIndex         int
+	approvalApprove       bool
+	approvalCommentMode   bool
+	approvalCommentBuffer string
+
+	// Workflow enable/disable confirmation(有効/無効切り替えの確認)
+	workflowToggleTarget *models.Workflow
+
+	// API debug log view(--debug時のAPIリクエスト履歴)
+	debugParentView  ViewState
+	debugEntries     []github.DebugEntry
+	debugOffset      int
+	debugShowBody    bool
+	debugSavedSearch logSearchState // 表示中はログビューの検索を共有するので、ログの検索結果を退避する
+
+	// Recent run statistics per workflow (nil while loading)
+	workflowStatsCache map[int64]*components.WorkflowStats
+	// Sequence of the debounced stats load of the visible workflows(スクロール中の連続読み込み防止)
+	workflowStatsLoadSeq int
+
+	// Schedule triggers per workflow (nil while loading)
+	workflowScheduleCache map[int64]*components.WorkflowSchedule
+	defaultBranch         string // リポジトリのデフォルトブランチ(取得済みの場合)
+}
+
+// listFilter represents the fuzzy filter state of a list view
+type listFilter struct {
+	query string
+	hide  bool // 確定後は一致しない項目を非表示にする
 }
 
 // NewApp creates a new TUI application
-func NewApp(client *github.Client, owner, repo string) *App {
-	keyMap := DefaultKeyMap()
+func NewApp(client *github.Client, owner, repo string, keyMap config.KeyMap, opts Options) *App {
 	styles := DefaultStyles()
 
+	perPage := opts.PerPage
+	if perPage <= 0 {
+		perPage = defaultPerPage
+	}
+
+	// Create run item delegate shared by all run lists
+	runDelegate := components.NewWorkflowRunItemDelegate(styles)
+	runDelegate.SetAbsoluteTime(opts.AbsoluteTime)
+	runDelegate.SetBlink(true)
+	listDensity := components.DensityComfortable
+	if i := slices.Index(config.ListDensities, opts.ListDensity); i >= 0 {
+		listDensity = i
+	}
+	runDelegate.SetDensity(listDensity)
+	var columns []components.RunColumn
+	for _, name := range opts.Columns {
+		if i := slices.Index(config.RunListColumnNames, name); i >= 0 {
+			columns = append(columns, components.RunColumn(i))
+		}
+	}
+	runDelegate.SetColumns(columns)
+	staleRunThreshold := opts.StaleRunThreshold
+	if staleRunThreshold <= 0 {
+		staleRunThreshold = components.DefaultStaleRunThreshold
+	}
+	runDelegate.SetStaleThreshold(staleRunThreshold)
+
+	logger := opts.Logger
+	if logger == nil {
+		logger = slog.New(slog.NewJSONHandler(io.Discard, nil))
+	}
+
 	// Create workflow list
-	workflowList := list.New([]list.Item{}, components.NewWorkflowItemDelegate(styles), 0, 0)
+	workflowDelegate := components.NewWorkflowItemDelegate(styles)
+	workflowList := list.New([]list.Item{}, workflowDelegate, 0, 0)
 	workflowList.Title = "Workflows"
 	workflowList.SetShowStatusBar(false)
 	workflowList.SetFilteringEnabled(false)
@@ -183,7 +661,7 @@ func NewApp(client *github.Client, owner, repo string) *App {
 	workflowList.Styles.Title = styles.GetTitle()
 
 	// Create runs list
-	runsList := list.New([]list.Item{}, components.NewWorkflowRunItemDelegate(styles), 0, 0)
+	runsList := list.New([]list.Item{}, runDelegate, 0, 0)
 	runsList.Title = "Workflow Runs"
 	runsList.SetShowStatusBar(false)
 	runsList.SetFilteringEnabled(false)
@@ -191,40 +669,92 @@ func NewApp(client *github.Client, owner, repo string) *App {
 	runsList.Styles.Title = styles.GetTitle()
 
 	// Create all runs list
-	allRunsList := list.New([]list.Item{}, components.NewWorkflowRunItemDelegate(styles), 0, 0)
+	allRunsList := list.New([]list.Item{}, runDelegate, 0, 0)
 	allRunsList.Title = "All Workflow Runs"
 	allRunsList.SetShowStatusBar(false)
 	allRunsList.SetFilteringEnabled(false)
 	allRunsList.SetShowHelp(false) // Hide help to show more items
 	allRunsList.Styles.Title = styles.GetTitle()
 
+	// Create branch runs list
+	branchRunsList := list.New([]list.Item{}, runDelegate, 0, 0)
+	branchRunsList.Title = "Workflow Runs"
+	branchRunsList.SetShowStatusBar(false)
+	branchRunsList.SetFilteringEnabled(false)
+	branchRunsList.SetShowHelp(false) // Hide help to show more items
+	branchRunsList.Styles.Title = styles.GetTitle()
+
+	// Create pull request runs list
+	prRunsList := list.New([]list.Item{}, runDelegate, 0, 0)
+	prRunsList.Title = "Workflow Runs"
+	prRunsList.SetShowStatusBar(false)
+	prRunsList.SetFilteringEnabled(false)
+	prRunsList.SetShowHelp(false) // Hide help to show more items
+	prRunsList.Styles.Title = styles.GetTitle()
+
 	// Create preview panel
 	previewPanel := components.NewPreviewPanel(styles)
+	previewPanel.SetStaleThreshold(staleRunThreshold)
 
 	return &App{
-		client:            client,
-		owner:             owner,
-		repo:              repo,
-		viewState:         AllRunsView,
-		keyMap:            keyMap,
-		styles:            styles,
-		help:              help.New(),
-		workflowList:      workflowList,
-		runsList:          runsList,
-		allRunsList:       allRunsList,
-		previewPanel:      previewPanel,
-		logProcessor:      logs.NewProcessor(styles.GetContent()),
-		loading:           true,
-		workflowsPage:     1,
-		workflowsPerPage:  100,
-		allRunsPage:       1,
-		allRunsPerPage:    100,
-		jobsCache:         NewJobsCache(10 * time.Minute),
-		logsCache:         make(map[int64]string),
-		workflowFileCache: make(map[string]string),
+		client:                client,
+		owner:                 owner,
+		repo:                  repo,
+		viewState:             AllRunsView,
+		keyMap:                keyMap,
+		styles:                styles,
+		help:                  help.New(),
+		workflowList:          workflowList,
+		runsList:              runsList,
+		allRunsList:           allRunsList,
+		branchRunsList:        branchRunsList,
+		prRunsList:            prRunsList,
+		previewPanel:          previewPanel,
+		logProcessor:          logs.NewProcessor(styles.GetContent()),
+		loading:               true,
+		workflowsPage:         1,
+		workflowsPerPage:      perPage,
+		allRunsPage:           1,
+		allRunsPerPage:        perPage,
+		workflowRunsPage:      1,
+		workflowRunsPerPage:   perPage,
+		branchRunsPage:        1,
+		prRunsPage:            1,
+		jobsCache:             NewJobsCacheWithOptions(completedJobsCacheTTL, defaultJobsCacheMaxEntries),
+		billingCache:          newTTLCache[int64, *models.WorkflowRunUsage](10*time.Minute, defaultJobsCacheMaxEntries),
+		logsCache:             newTTLCache[int64, *models.RunLogs](defaultLogsCacheTTL, 0),
+		artifactsCache:        newTTLCache[int64, []models.Artifact](defaultArtifactsCacheTTL, 0),
+		jobLogsCache:          make(map[int64]string),
+		workflowFileCache:     make(map[string]string),
+		refreshInterval:       opts.RefreshInterval,
+		created:               opts.Created,
+		defaultBranchFilter:   opts.Branch,
+		selectedRuns:          make(map[int64]bool),
+		runDelegate:           runDelegate,
+		bookmarks:             opts.Bookmarks,
+		searchHistory:         opts.SearchHistory,
+		searchHistoryIndex:    -1,
+		recentRepos:           opts.RecentRepos,
+		logger:                logger,
+		logCursorLine:         -1,
+		noColor:               opts.NoColor,
+		watch:                 opts.Watch,
+		workflowDelegate:      workflowDelegate,
+		lastClickIndex:        -1,
+		listDensity:           listDensity,
+		sortKey:               runSortTime,
+		previewVisible:        true,
+		workflowStatsCache:    make(map[int64]*components.WorkflowStats),
+		workflowScheduleCache: make(map[int64]*components.WorkflowSchedule),
+		pendingDeployments:    make(map[int64][]models.PendingDeployment),
 	}
 }
 
+// SearchHistory returns the log search history, most recent query first
+func (a *App) SearchHistory() []string {
+	return a.searchHistory
+}
+
 // Init initializes the application
 func (a *App) Init() tea.Cmd {
 	// Start periodic cache cleanup
@@ -233,17 +763,90 @@ func (a *App) Init() tea.Cmd {
 		defer ticker.Stop()
 		for range ticker.C {
 			a.jobsCache.Cleanup()
+			a.logsCache.Cleanup()
+			a.billingCache.Cleanup()
+			a.artifactsCache.Cleanup()
 		}
 	}()
 
 	return tea.Batch(
 		a.loadAllRunsPaginated(),
 		tea.EnterAltScreen,
+		a.scheduleAutoRefresh(),
+		a.scheduleWatch(),
 	)
 }
 
+// blinkInterval is the interval of the blinking marker of in-progress runs
+const blinkInterval = 500 * time.Millisecond
+
+// scheduleBlink schedules the next blink tick while any loaded run is in progress
+func (a *App) scheduleBlink() tea.Cmd {
+	if a.blinkScheduled {
+		return nil
+	}
+	hasRunning := false
+	for _, runs := range [][]models.WorkflowRun{a.allRuns, a.workflowRuns, a.branchRuns, a.prRuns} {
+		if running, _ := components.CountActiveRuns(runs); running > 0 {
+			hasRunning = true
+			break
+		}
+	}
+	if !hasRunning {
+		// 点滅を止めたときはマーカーを表示したままにする
+		a.runDelegate.SetBlink(true)
+		return nil
+	}
+
+	a.blinkScheduled = true
+	return tea.Tick(blinkInterval, func(time.Time) tea.Msg {
+		return blinkMsg{}
+	})
+}
+
+// scheduleAutoRefresh schedules the next auto-refresh tick
+func (a *App) scheduleAutoRefresh() tea.Cmd {
+	if a.refreshInterval <= 0 {
+		return nil
+	}
+	return tea.Tick(a.refreshInterval, func(time.Time) tea.Msg {
+		return autoRefreshMsg{}
+	})
+}
+
+// autoRefresh reloads the current view in the background without showing the loading screen
+func (a *App) autoRefresh() tea.Cmd {
+	// ログ閲覧中や入力中は読み込みを中断しない
+	if a.loading || a.err != nil || a.viewingWorkflowFile || a.isInputMode() {
+		return nil
+	}
+
+	switch a.viewState {
+	case AllRunsView:
+		return a.loadAllRunsPaginated()
+	case WorkflowListView:
+		return a.loadWorkflowsPaginated()
+	case WorkflowRunsView:
+		if a.currentWorkflow != nil {
+			return a.loadWorkflowRuns(a.currentWorkflow.ID)
+		}
+	case BranchRunsView:
+		return a.loadBranchRunsPaginated()
+	case PRRunsView:
+		return a.loadPRRunsPaginated()
+	}
+	return nil
+}
+
+// isInputMode reports whether any text input mode is active
+func (a *App) isInputMode() bool {
+	return a.searchInputMode || a.jumpInputMode || a.saveInputMode || a.listFilterInputMode || a.branchInputMode || a.prInputMode || a.dispatchInputMode || a.approvalMode || a.bookmarkMode || a.diffInputMode || a.eventPickerMode || a.actorInputMode || a.commitSearchInputMode || a.workflowToggleTarget != nil || a.annotationDetail != nil
+}
+
 // Update handles messages and updates the application state
 func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	defer a.logViewTransition(a.viewState)
+
 	switch msg := msg.(type) {
 	case tea.WindowSizeMsg:
 		a.width = msg.Width
@@ -254,79 +857,293 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case tea.KeyMsg:
 		return a.handleKeyMsg(msg)
 
+	case tea.MouseMsg:
+		return a.handleMouseMsg(msg)
+
 	case workflowsLoadedMsg:
 		a.workflows = msg.workflows
 		a.loading = false
 		a.updateWorkflowList()
+		return a, tea.Batch(a.loadSelectedWorkflowDetails(), a.scheduleWorkflowStatsLoad())
+
+	case workflowScheduleLoadedMsg:
+		if msg.branch != "" {
+			a.defaultBranch = msg.branch
+		}
+		if msg.content != "" {
+			a.workflowFileCache[msg.workflow.Path+"@"+msg.branch] = msg.content
+		}
+		if msg.err != nil {
+			// スケジュールは補助情報なので表示しないだけにする
+			a.workflowScheduleCache[msg.workflow.ID] = &components.WorkflowSchedule{}
+			return a, nil
+		}
+		a.workflowScheduleCache[msg.workflow.ID] = msg.schedule
+		return a, nil
+
+	case workflowStatsLoadedMsg:
+		if msg.err != nil {
+			// 次に選択されたときに再取得する
+			delete(a.workflowStatsCache, msg.workflowID)
+			return a, nil
+		}
+		a.workflowStatsCache[msg.workflowID] = msg.stats
+		a.updateWorkflowList()
 		return a, nil
 
+	case workflowStatsDebounceMsg:
+		if msg.seq != a.workflowStatsLoadSeq || a.viewState != WorkflowListView {
+			return a, nil
+		}
+		return a, a.loadVisibleWorkflowStats()
+
 	case workflowRunsLoadedMsg:
 		a.workflowRuns = msg.runs
+		a.workflowRunsTotal = msg.total
+		a.workflowRunsPage = msg.page
 		a.loading = false
+		a.paginationInProgress = false
 		a.updateWorkflowRunsList()
 
-		// Load jobs for the first run if available
-		if len(a.workflowRuns) > 0 {
-			return a, a.loadWorkflowRunJobs(a.workflowRuns[0].ID)
+		// Load jobs for the selected run if available
+		blinkCmd := a.scheduleBlink()
+		if run := selectedRunInList(a.runsList); run != nil {
+			return a, tea.Batch(a.loadWorkflowRunJobs(run.ID), blinkCmd)
 		}
-		return a, nil
+		return a, blinkCmd
 
 	case errorMsg:
 		a.err = msg.err
 		a.loading = false
+		a.paginationInProgress = false
+		return a, nil
+
+	case paginationSpinnerMsg:
+		return a.handlePaginationSpinner()
+
+	case approvalDeploymentsLoadedMsg:
+		return a.openApprovalDialog(msg)
+
+	case deploymentReviewedMsg:
+		return a.handleDeploymentReviewed(msg)
+
+	case batchRunActionMsg:
+		return a.handleBatchRunActionResult(msg)
+
+	case workflowToggledMsg:
+		return a.handleWorkflowToggled(msg)
+
+	case dispatchFormLoadedMsg:
+		return a.openDispatchForm(msg)
+
+	case workflowDispatchedMsg:
+		if msg.err != nil {
+			return a, a.flashStatus(fmt.Sprintf("Failed to dispatch %s: %v", msg.workflow.Name, msg.err))
+		}
+		return a, a.flashStatus(fmt.Sprintf("Dispatched %s on %s", msg.workflow.Name, msg.ref))
+
+	case browserOpenedMsg:
+		if msg.err != nil {
+			// 開けなかった場合は理由と一緒にURLを表示してコピーできるようにする
+			return a, a.flashStatus(fmt.Sprintf("Failed to open browser (%v): %s", msg.err, msg.url))
+		}
+		return a, nil
+
+	case tokenInfoLoadedMsg:
+		return a.handleTokenInfoLoaded(msg)
+
+	case runGraphLoadedMsg:
+		return a.handleRunGraphLoaded(msg)
+
+	case runAttemptsLoadedMsg:
+		return a.handleRunAttemptsLoaded(msg)
+
+	case workflowEnvLoadedMsg:
+		return a.handleWorkflowEnvLoaded(msg)
+
+	case clipboardCopiedMsg:
+		return a.handleClipboardCopied(msg)
+
+	case logsSavedMsg:
+		return a, a.flashStatus(fmt.Sprintf("Saved logs to %s", msg.path))
+
+	case clearStatusMsg:
+		if msg.id == a.statusMessageID {
+			a.statusMessage = ""
+		}
 		return a, nil
 
+	case autoRefreshMsg:
+		return a, tea.Batch(a.autoRefresh(), a.scheduleAutoRefresh())
+
+	case artifactsLoadedMsg:
+		return a.handleArtifactsLoaded(msg)
+
+	case artifactDownloadedMsg:
+		return a.handleArtifactDownloaded(msg)
+
+	case artifactDownloadTickMsg:
+		return a, a.scheduleArtifactDownloadTick()
+
+	case cacheUsageLoadedMsg:
+		return a.handleCacheUsageLoaded(msg)
+
+	case cachesLoadedMsg:
+		return a.handleCachesLoaded(msg)
+
+	case cacheDeletedMsg:
+		return a.handleCacheDeleted(msg)
+
+	case watchTickMsg:
+		return a, a.pollWatchRuns()
+
+	case watchRunsLoadedMsg:
+		return a.handleWatchRunsLoaded(msg)
+
+	case blinkMsg:
+		a.blinkScheduled = false
+		a.runDelegate.SetBlink(!a.runDelegate.Blink())
+		return a, a.scheduleBlink()
+
+	case jobLogsLoadedMsg:
+		return a.handleJobLogsLoaded(msg)
+
+	case annotationsLoadedMsg:
+		return a.handleAnnotationsLoaded(msg)
+
 	case logsLoadedMsg:
-		a.logs = msg.logs
+		// 読み込み中に別のランのログを開いていたら破棄する
+		if a.currentRun == nil || a.currentRun.ID != msg.runID {
+			return a, nil
+		}
+		if msg.err != nil {
+			a.err = msg.err
+			a.loading = false
+			return a, nil
+		}
+		// ジョブ単位のログ表示中はラン全体のログで上書きしない
+		if a.jobLog != nil {
+			a.loading = false
+			return a, nil
+		}
+		a.logs = msg.logs.Content
+		a.logSections = msg.logs.Sections
+		a.stepSections = parseStepLogSections(strings.Split(a.logs, "\n"))
+		a.rebuildLogDisplayLines()
+		a.mapLogAnnotations()
 		a.loading = false
+		if a.logTail {
+			a.scrollLogsToTail()
+		}
 		return a, nil
 
 	case jobsLoadedMsg:
+		// 読み込み中にリポジトリを切り替えていたら破棄する
+		if msg.owner != a.owner || msg.repo != a.repo {
+			return a, nil
+		}
+		if !msg.cached {
+			a.jobsCache.Set(msg.runID, msg.jobs, jobsCacheTTL(msg.status, msg.jobs))
+		}
 		a.currentJobs = msg.jobs
+		a.setJobsLoading(false)
+		a.syncJobsSpinner()
+		return a, nil
+
+	case runUsageDebounceMsg:
+		// デバウンス中に別のランへ移動していれば読み込まない
+		if run := a.selectedRun(); run != nil && run.ID == msg.runID {
+			return a, a.loadRunUsage(msg.runID)
+		}
 		return a, nil
 
+	case runUsageLoadedMsg:
+		return a.handleRunUsageLoaded(msg)
+
+	case jobsSpinnerMsg:
+		return a.handleJobsSpinner()
+
 	case allRunsLoadedMsg:
 		a.allRuns = msg.runs
 		a.loading = false
 		a.updateAllRunsList()
 
-		// Load jobs for the first run if available
-		if len(a.allRuns) > 0 {
-			return a, a.loadWorkflowRunJobs(a.allRuns[0].ID)
+		// Load jobs for the selected run if available
+		blinkCmd := a.scheduleBlink()
+		if run := selectedRunInList(a.allRunsList); run != nil {
+			return a, tea.Batch(a.loadWorkflowRunJobs(run.ID), blinkCmd)
 		}
-		return a, nil
+		return a, blinkCmd
 
 	case workflowsPaginatedLoadedMsg:
 		a.workflows = msg.workflows
 		a.workflowsTotal = msg.total
 		a.workflowsPage = msg.page
 		a.loading = false
+		a.paginationInProgress = false
 		a.updateWorkflowList()
-		return a, nil
+		return a, tea.Batch(a.loadSelectedWorkflowDetails(), a.scheduleWorkflowStatsLoad())
 
 	case allRunsPaginatedLoadedMsg:
 		a.allRuns = msg.runs
 		a.allRunsTotal = msg.total
 		a.allRunsPage = msg.page
 		a.loading = false
+		a.paginationInProgress = false
 		a.updateAllRunsList()
 
-		// Load jobs for the first run if available
-		if len(a.allRuns) > 0 {
-			return a, a.loadWorkflowRunJobs(a.allRuns[0].ID)
+		// Load jobs for the selected run if available
+		blinkCmd := a.scheduleBlink()
+		if run := selectedRunInList(a.allRunsList); run != nil {
+			return a, tea.Batch(a.loadWorkflowRunJobs(run.ID), blinkCmd)
 		}
-		return a, nil
+		return a, blinkCmd
+	case branchRunsPaginatedLoadedMsg:
+		a.branchRuns = msg.runs
+		a.branchRunsTotal = msg.total
+		a.branchRunsPage = msg.page
+		a.loading = false
+		a.paginationInProgress = false
+		a.updateBranchRunsList()
+
+		// Load jobs for the selected run if available
+		blinkCmd := a.scheduleBlink()
+		if run := selectedRunInList(a.branchRunsList); run != nil {
+			return a, tea.Batch(a.loadWorkflowRunJobs(run.ID), blinkCmd)
+		}
+		return a, blinkCmd
+	case prRunsPaginatedLoadedMsg:
+		a.prRuns = msg.runs
+		a.prRunsTotal = msg.total
+		a.prRunsPage = msg.page
+		a.loading = false
+		a.paginationInProgress = false
+		a.updatePRRunsList()
+
+		// Load jobs for the selected run if available
+		blinkCmd := a.scheduleBlink()
+		if run := selectedRunInList(a.prRunsList); run != nil {
+			return a, tea.Batch(a.loadWorkflowRunJobs(run.ID), blinkCmd)
+		}
+		return a, blinkCmd
+	case workflowDiffLoadedMsg:
+		return a.handleWorkflowDiffLoaded(msg)
 	case workflowFileLoadedMsg:
+		if msg.defaultBranch != "" {
+			a.defaultBranch = msg.defaultBranch
+		}
 		a.workflowFileLoading = false
 		a.workflowFilePath = msg.path
 		a.workflowFileRef = msg.ref
 		if msg.err != nil {
 			a.workflowFileContent = "Failed to fetch workflow file: " + msg.err.Error()
+			a.workflowFileErrors = nil
 		} else {
 			// 正常取得時のみキャッシュに格納
 			key := msg.path + "@" + msg.ref
 			a.workflowFileCache[key] = msg.content
 			a.workflowFileContent = msg.content
+			a.workflowFileErrors = validateWorkflowYAML(msg.content)
 		}
 		a.viewingWorkflowFile = true
 		return a, nil
@@ -337,18 +1154,34 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 // handleNextPage handles next page navigation
 func (a *App) handleNextPage() (tea.Model, tea.Cmd) {
+	if a.paginationInProgress {
+		return a, nil
+	}
 	switch a.viewState {
 	case WorkflowListView:
 		if a.workflowsPage*a.workflowsPerPage < a.workflowsTotal {
 			a.workflowsPage++
-			a.loading = true
-			return a, a.loadWorkflowsPaginated()
+			return a, a.startPagination(a.loadWorkflowsPaginated())
 		}
 	case AllRunsView:
 		if a.allRunsPage*a.allRunsPerPage < a.allRunsTotal {
 			a.allRunsPage++
-			a.loading = true
-			return a, a.loadAllRunsPaginated()
+			return a, a.startPagination(a.loadAllRunsPaginated())
+		}
+	case WorkflowRunsView:
+		if a.currentWorkflow != nil && a.workflowRunsPage*a.workflowRunsPerPage < a.workflowRunsTotal {
+			a.workflowRunsPage++
+			return a, a.startPagination(a.loadWorkflowRuns(a.currentWorkflow.ID))
+		}
+	case BranchRunsView:
+		if a.branchRunsPage*a.allRunsPerPage < a.branchRunsTotal {
+			a.branchRunsPage++
+			return a, a.startPagination(a.loadBranchRunsPaginated())
+		}
+	case PRRunsView:
+		if a.prRunsPage*a.allRunsPerPage < a.prRunsTotal {
+			a.prRunsPage++
+			return a, a.startPagination(a.loadPRRunsPaginated())
 		}
 	}
 	return a, nil
@@ -356,18 +1189,34 @@ func (a *App) handleNextPage() (tea.Model, tea.Cmd) {
 
 // handlePrevPage handles previous page navigation
 func (a *App) handlePrevPage() (tea.Model, tea.Cmd) {
+	if a.paginationInProgress {
+		return a, nil
+	}
 	switch a.viewState {
 	case WorkflowListView:
 		if a.workflowsPage > 1 {
 			a.workflowsPage--
-			a.loading = true
-			return a, a.loadWorkflowsPaginated()
+			return a, a.startPagination(a.loadWorkflowsPaginated())
 		}
 	case AllRunsView:
 		if a.allRunsPage > 1 {
 			a.allRunsPage--
-			a.loading = true
-			return a, a.loadAllRunsPaginated()
+			return a, a.startPagination(a.loadAllRunsPaginated())
+		}
+	case WorkflowRunsView:
+		if a.currentWorkflow != nil && a.workflowRunsPage > 1 {
+			a.workflowRunsPage--
+			return a, a.startPagination(a.loadWorkflowRuns(a.currentWorkflow.ID))
+		}
+	case BranchRunsView:
+		if a.branchRunsPage > 1 {
+			a.branchRunsPage--
+			return a, a.startPagination(a.loadBranchRunsPaginated())
+		}
+	case PRRunsView:
+		if a.prRunsPage > 1 {
+			a.prRunsPage--
+			return a, a.startPagination(a.loadPRRunsPaginated())
 		}
 	}
 	return a, nil
@@ -384,34 +1233,76 @@ func (a *App) getPaginationInfo(page, total, perPage int) string {
 
 // View renders the application
 func (a *App) View() string {
+	if a.noColor {
+		return logs.StripANSI(a.renderView())
+	}
+	return a.renderView()
+}
+
+// renderView renders the current view
+func (a *App) renderView() string {
 	if a.width == 0 || a.height == 0 {
 		return "Loading..."
 	}
 
-	if a.err != nil {
-		return a.renderError(a.err)
+	if a.showHelp {
+		return a.renderHelpView()
 	}
 
-	if a.viewingWorkflowFile {
-		return a.renderWorkflowFileView()
+	if a.showInfo {
+		return a.renderInfoView()
 	}
 
-	if a.loading {
-		return a.styles.GetStatusInProgress().Render("Loading...")
+	if a.showRunGraph {
+		return a.renderRunGraphView()
 	}
 
-	switch a.viewState {
-	case AllRunsView:
-		return a.renderAllRunsView()
-	case WorkflowListView:
-		return a.renderWorkflowListView()
-	case WorkflowRunsView:
-		return a.renderWorkflowRunsView()
-	case WorkflowRunLogsView:
-		return a.renderWorkflowRunLogsView()
+	if a.showRunAttempts {
+		return a.renderRunAttemptsView()
+	}
+
+	if a.showWorkflowEnv {
+		return a.renderWorkflowEnvView()
+	}
+
+	if a.showRepoSwitcher {
+		return a.renderRepoSwitcherView()
+	}
+
+	if a.err != nil {
+		return a.renderError(a.err)
+	}
+
+	var view string
+	switch {
+	case a.viewState == DebugLogView:
+		view = a.renderDebugLogView()
+	case a.viewingWorkflowFile:
+		view = a.renderWorkflowFileView()
+	case a.loading:
+		return a.styles.GetStatusInProgress().Render("Loading...")
+	case a.viewState == AllRunsView:
+		view = a.renderAllRunsView()
+	case a.viewState == WorkflowListView:
+		view = a.renderWorkflowListView()
+	case a.viewState == WorkflowRunsView:
+		view = a.renderWorkflowRunsView()
+	case a.viewState == WorkflowRunLogsView:
+		view = a.renderWorkflowRunLogsView()
+	case a.viewState == BranchRunsView:
+		view = a.renderBranchRunsView()
+	case a.viewState == PRRunsView:
+		view = a.renderPRRunsView()
+	case a.viewState == ArtifactsView:
+		view = a.renderArtifactsView()
+	case a.viewState == CachesView:
+		view = a.renderCachesView()
 	default:
 		return "Unknown view state"
 	}
+
+	// 全ビュー共通でパンくずを先頭に表示する
+	return lipgloss.JoinVertical(lipgloss.Left, a.renderBreadcrumb(), view)
 }
 
 // handleKeyMsg handles keyboard input
@@ -423,76 +1314,177 @@ func (a *App) handleKeyMsg(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	if a.jumpInputMode {
 		return a.handleJumpInput(msg)
 	}
+	if a.saveInputMode {
+		return a.handleSaveInput(msg)
+	}
+	if a.bookmarkMode {
+		return a.handleBookmarkInput(msg)
+	}
+	if a.listFilterInputMode {
+		return a.handleListFilterInput(msg)
+	}
+	if a.branchInputMode {
+		return a.handleBranchInput(msg)
+	}
+	if a.prInputMode {
+		return a.handlePRInput(msg)
+	}
+	if a.diffInputMode {
+		return a.handleDiffInput(msg)
+	}
+	if a.dispatchInputMode {
+		return a.handleDispatchInput(msg)
+	}
+	if a.approvalMode {
+		return a.handleApprovalInput(msg)
+	}
+	if a.workflowToggleTarget != nil {
+		return a.handleWorkflowToggleConfirm(msg)
+	}
+	if a.eventPickerMode {
+		return a.handleEventPickerInput(msg)
+	}
+	if a.actorInputMode {
+		return a.handleActorInput(msg)
+	}
+	if a.commitSearchInputMode {
+		return a.handleCommitSearchInput(msg)
+	}
+	if a.annotationDetail != nil {
+		return a.handleAnnotationDetailInput(msg)
+	}
 
-	// --- グローバルキー ---
-	switch {
-	case key.Matches(msg, a.keyMap.Quit):
-		return a, tea.Quit
+	// ヘルプ表示中は閉じる操作のみ受け付ける
+	if a.showHelp {
+		if key.Matches(msg, a.keyMap.Help, a.keyMap.Back, a.keyMap.Quit) {
+			a.showHelp = false
+		}
+		return a, nil
 	}
 
-	// Workflow file view
-	if a.viewingWorkflowFile {
-		if msg.Type == tea.KeyEsc || key.Matches(msg, a.keyMap.Left) {
-			a.viewingWorkflowFile = false
-			a.workflowFileContent = ""
-			a.workflowFilePath = ""
-			a.workflowFileOffset = 0
-			return a, nil
+	// 情報パネル表示中も閉じる操作のみ受け付ける
+	if a.showInfo {
+		if msg.String() == "I" || key.Matches(msg, a.keyMap.Back, a.keyMap.Quit) {
+			a.showInfo = false
 		}
-		if a.workflowFileLoading { // ignore keys while loading
-			return a, nil
+		return a, nil
+	}
+
+	// グラフ表示中も閉じる操作のみ受け付ける
+	if a.showRunGraph {
+		if msg.String() == "L" || key.Matches(msg, a.keyMap.Back, a.keyMap.Quit) {
+			a.showRunGraph = false
 		}
+		return a, nil
+	}
 
-		lines := strings.Split(a.workflowFileContent, "\n")
-		viewHeight := a.height - 4
-		if viewHeight < 1 {
-			viewHeight = 1
+	// アテンプト一覧の表示中も閉じる操作のみ受け付ける
+	if a.showRunAttempts {
+		if msg.String() == "H" || key.Matches(msg, a.keyMap.Back, a.keyMap.Quit) {
+			a.showRunAttempts = false
 		}
-		maxOffset := len(lines) - viewHeight
-		if maxOffset < 0 {
-			maxOffset = 0
+		return a, nil
+	}
+
+	// env変数名の表示中も閉じる操作のみ受け付ける
+	if a.showWorkflowEnv {
+		if msg.String() == "E" || key.Matches(msg, a.keyMap.Back, a.keyMap.Quit) {
+			a.showWorkflowEnv = false
 		}
+		return a, nil
+	}
 
-		switch {
-		case key.Matches(msg, a.keyMap.Up):
-			if a.workflowFileOffset > 0 {
-				a.workflowFileOffset--
-			}
-		case key.Matches(msg, a.keyMap.Down):
-			if a.workflowFileOffset < maxOffset {
-				a.workflowFileOffset++
-			}
-		case key.Matches(msg, a.keyMap.PageUp):
-			a.workflowFileOffset -= viewHeight
-			if a.workflowFileOffset < 0 {
-				a.workflowFileOffset = 0
-			}
-		case key.Matches(msg, a.keyMap.PageDown):
-			a.workflowFileOffset += viewHeight
-			if a.workflowFileOffset > maxOffset {
-				a.workflowFileOffset = maxOffset
-			}
-		case key.Matches(msg, a.keyMap.Home) || msg.String() == "g":
+	if a.showRepoSwitcher {
+		return a.handleRepoSwitcherInput(msg)
+	}
+
+	// --- グローバルキー ---
+	switch {
+	case key.Matches(msg, a.keyMap.Quit):
+		return a, tea.Quit
+	case key.Matches(msg, a.keyMap.Help):
+		a.showHelp = true
+		return a, nil
+	case msg.String() == "i" && a.viewState == WorkflowRunLogsView && a.currentRun != nil:
+		// ランの情報はログに重ねて表示する(トークン情報はどのビューでもIで開ける)
+		a.showRunMeta = !a.showRunMeta
+		return a, nil
+	case msg.String() == "I":
+		return a, a.openInfoPanel()
+	case msg.String() == "S" && a.viewState != WorkflowRunLogsView && a.viewState != ArtifactsView && a.viewState != DebugLogView && !a.viewingWorkflowFile:
+		return a, a.openRepoSwitcher()
+	case msg.String() == "ctrl+d" && a.viewState != DebugLogView && (a.client.DebugLogEnabled() || (a.viewState != WorkflowRunLogsView && !a.viewingWorkflowFile)):
+		// --debugなしではログ表示系のビューのctrl+dはページ送りのまま
+		return a.openDebugLogView()
+	}
+
+	// API debug log view(ワークフローファイル表示中にも開けるので先に処理する)
+	if a.viewState == DebugLogView {
+		return a.handleDebugLogKey(msg)
+	}
+
+	// Workflow file view
+	if a.viewingWorkflowFile {
+		if key.Matches(msg, a.keyMap.Back, a.keyMap.Left) {
+			a.viewingWorkflowFile = false
+			a.workflowFileContent = ""
+			a.workflowFilePath = ""
 			a.workflowFileOffset = 0
-		case key.Matches(msg, a.keyMap.End) || msg.String() == "G":
-			a.workflowFileOffset = maxOffset
+			a.workflowFileDiff = nil
+			return a, nil
+		}
+		if a.workflowFileLoading { // ignore keys while loading
+			return a, nil
+		}
+
+		viewHeight := a.height - 4 - breadcrumbHeight
+		if len(a.workflowFileErrors) > 0 {
+			viewHeight--
+		}
+		if viewHeight < 1 {
+			viewHeight = 1
 		}
+		maxOffset := a.workflowFileLineCount() - viewHeight
+		if maxOffset < 0 {
+			maxOffset = 0
+		}
+
+		a.workflowFileOffset, _ = a.scrollOffset(msg, a.workflowFileOffset, viewHeight, maxOffset)
 		return a, nil
 	}
 
+	// Artifacts view
+	if a.viewState == ArtifactsView {
+		return a.handleArtifactsKey(msg)
+	}
+
+	// Actions cache view
+	if a.viewState == CachesView {
+		return a.handleCachesKey(msg)
+	}
+
 	// Logs view
 	if a.viewState == WorkflowRunLogsView {
+		if a.showJobSidebar {
+			return a.handleJobSidebarKey(msg)
+		}
+		if a.showRunMeta && key.Matches(msg, a.keyMap.Back) {
+			a.showRunMeta = false
+			return a, nil
+		}
+		// Jでジョブ選択サイドバーを開く
+		if msg.String() == "J" && a.currentRun != nil {
+			return a.openJobSidebar()
+		}
+
 		// 検索入力モード
 		if (msg.String() == "f" || key.Matches(msg, a.keyMap.Right)) && a.currentRun != nil {
-			path := a.currentRun.Path
-			ref := a.currentRun.HeadSha
-			if path == "" && a.currentWorkflow != nil { // fallback
-				path = a.currentWorkflow.Path
-			}
-			if path != "" && ref != "" {
+			path, ref := a.runWorkflowFile()
+			if path != "" {
 				key := path + "@" + ref
 				if cached, ok := a.workflowFileCache[key]; ok { // キャッシュヒット
 					a.workflowFileContent = cached
+					a.workflowFileErrors = validateWorkflowYAML(cached)
 					a.workflowFilePath = path
 					a.workflowFileRef = ref
 					a.workflowFileLoading = false
@@ -506,16 +1498,18 @@ func (a *App) handleKeyMsg(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 				a.workflowFileRef = ref
 				a.viewingWorkflowFile = true
 				return a, func() tea.Msg {
-					content, err := a.client.GetWorkflowFileAtRef(a.owner, a.repo, path, ref)
-					return workflowFileLoadedMsg{content: content, path: path, ref: ref, err: err}
+					return a.fetchWorkflowFile(path, ref)
 				}
 			}
 		}
+		// Eでワークフローのenv:に書かれた変数名を表示する
+		if msg.String() == "E" && a.currentRun != nil {
+			return a, a.openWorkflowEnv()
+		}
 
 		// /で検索入力モード開始
 		if msg.String() == "/" {
-			a.searchInputMode = true
-			a.searchInputBuffer = ""
+			a.startSearchInput()
 			return a, nil
 		}
 		// :でジャンプ入力モード開始
@@ -524,51 +1518,155 @@ func (a *App) handleKeyMsg(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			a.jumpInputBuffer = ""
 			return a, nil
 		}
+		// Aで承認待ちデプロイの承認/却下
+		if msg.String() == "A" && a.currentRun != nil {
+			return a, a.loadApprovalDeployments(*a.currentRun)
+		}
+		// aでアーティファクト一覧
+		if msg.String() == "a" && a.currentRun != nil {
+			return a.openArtifactsView()
+		}
+		// oでブラウザを開く(カーソル行にURLがあればそのURLを開く)
+		if msg.String() == "o" && a.currentRun != nil {
+			if url := a.cursorLogURL(strings.Split(a.logs, "\n"), a.height-6-breadcrumbHeight); url != "" {
+				return a, a.openInBrowser(url)
+			}
+			return a, a.openInBrowser(a.currentRun.HTMLURL)
+		}
+		// yで先頭行、Yで表示中の行をクリップボードにコピー
+		if msg.String() == "y" || msg.String() == "Y" {
+			return a, a.copyLogLines(msg.String() == "Y")
+		}
+		// cでコミットSHAをコピー(git checkout用)
+		if msg.String() == "c" && a.currentRun != nil {
+			return a, a.copyRunSHA(a.currentRun.HeadSha)
+		}
+		// Bで先頭行をブックマーク、bでブックマーク一覧
+		if msg.String() == "B" {
+			return a, a.addLogBookmark()
+		}
+		if msg.String() == "b" {
+			return a, a.openBookmarkList()
+		}
+		// sでログ保存先入力モード開始
+		if msg.String() == "s" && a.currentRun != nil && a.logs != "" {
+			a.saveInputMode = true
+			a.saveInputBuffer = fmt.Sprintf("./run-%d.log", a.currentRun.ID)
+			return a, nil
+		}
 		switch {
+		case key.Matches(msg, a.keyMap.Left) && a.jobLog != nil:
+			// ジョブ単位のログからラン全体のログに戻る
+			return a.closeJobLog()
 		case key.Matches(msg, a.keyMap.Left):
 			return a.goBack()
-		case msg.Type == tea.KeyEsc:
+		case key.Matches(msg, a.keyMap.Back):
 			a.searchActiveQuery = "" // エスケープ時はハイライト消す
 			a.searchMatchIndices = nil
 			a.searchMatchIndex = -1
+			a.clearSearchInvert()
+		// !: 一致しなかった行だけを表示する反転モードを切り替え
+		case msg.String() == "!":
+			return a, a.toggleSearchInvert()
 		// n: 次の検索ヒットへジャンプ
 		case msg.String() == "n":
-			if a.searchActiveQuery != "" && len(a.searchMatchIndices) > 0 {
-				a.searchMatchIndex = (a.searchMatchIndex + 1) % len(a.searchMatchIndices)
-				lines := strings.Split(a.logs, "\n")
-				maxOffset := len(lines) - (a.height - 6)
-				if maxOffset < 0 {
-					maxOffset = 0
-				}
-				offset := a.searchMatchIndices[a.searchMatchIndex]
-				if offset > maxOffset {
-					offset = maxOffset
-				}
-				a.logOffset = offset
+			a.jumpToNextSearchMatch(true)
+		// +/-: 検索ヒット行の前後に表示するコンテキスト行数を増減
+		case (msg.String() == "+" || msg.String() == "-") && a.searchActiveQuery != "":
+			if msg.String() == "+" {
+				a.searchContextLines++
+			} else if a.searchContextLines > 0 {
+				a.searchContextLines--
 			}
+			return a, a.flashStatus(fmt.Sprintf("Context: %d lines", a.searchContextLines))
 		// Shift+n (N): 前の検索ヒットへジャンプ
 		case msg.String() == "N":
-			if a.searchActiveQuery != "" && len(a.searchMatchIndices) > 0 {
-				a.searchMatchIndex = (a.searchMatchIndex - 1 + len(a.searchMatchIndices)) % len(a.searchMatchIndices)
-				lines := strings.Split(a.logs, "\n")
-				maxOffset := len(lines) - (a.height - 6)
-				if maxOffset < 0 {
-					maxOffset = 0
-				}
-				offset := a.searchMatchIndices[a.searchMatchIndex]
-				if offset > maxOffset {
-					offset = maxOffset
-				}
-				a.logOffset = offset
-			}
+			a.jumpToNextSearchMatch(false)
 		}
 		return a.handleLogNavigation(msg)
 	}
 
 	// Other views
 	switch {
+	case key.Matches(msg, a.keyMap.Back) && len(a.selectedRuns) > 0:
+		// 選択中のEscは選択を解除する
+		a.clearRunSelection()
+		return a, nil
+	case msg.Type == tea.KeySpace && a.selectableRunsList() != nil:
+		a.toggleRunSelection(a.selectableRunsList())
+		return a, nil
+	case msg.String() == "X" && len(a.selectedRuns) > 0:
+		return a, a.batchRunAction("cancel")
+	case msg.String() == "R" && len(a.selectedRuns) > 0:
+		return a, a.batchRunAction("rerun")
+	case key.Matches(msg, a.keyMap.Back) && a.currentListFilter() != nil && a.currentListFilter().query != "":
+		// 絞り込み中のEscは絞り込みを解除する
+		*a.currentListFilter() = listFilter{}
+		a.applyListFilter()
+		return a, nil
+	case key.Matches(msg, a.keyMap.Back) && a.viewState == AllRunsView && a.commitSearchQuery != "":
+		// コミットメッセージ検索中のEscは検索を解除する
+		a.setCommitSearch("")
+		return a, nil
+	case key.Matches(msg, a.keyMap.Back) && a.hasRunFilter() && a.isRunListView():
+		// イベント・実行者で絞り込み中のEscは絞り込みを解除して再取得する
+		return a, a.clearRunFilters()
 	case key.Matches(msg, a.keyMap.Back):
 		return a.goBack()
+	case msg.String() == "@" && a.isRunListView():
+		a.openActorInput()
+		return a, nil
+	case msg.String() == "c" && a.viewState == AllRunsView:
+		a.commitSearchInputMode = true
+		a.commitSearchBuffer = a.commitSearchQuery
+		return a, nil
+	case msg.String() == "e" && a.viewState == AllRunsView:
+		return a, a.openEventPicker()
+	case msg.String() == "b" && a.viewState == AllRunsView:
+		a.branchInputMode = true
+		a.branchInputBuffer = ""
+		return a, nil
+	case msg.String() == "O" && (a.viewState == AllRunsView || a.viewState == WorkflowRunsView):
+		// S はリポジトリ切り替えに使っているので O(order)で並び順を切り替える
+		return a, a.cycleRunSort()
+	case msg.String() == "L" && a.viewState == AllRunsView:
+		return a, a.openRunGraph()
+	case msg.String() == "H" && a.selectedRun() != nil:
+		return a, a.openRunAttempts()
+	case msg.String() == "P" && a.viewState == AllRunsView:
+		a.prInputMode = true
+		a.prInputBuffer = ""
+		return a, nil
+	case msg.String() == "D" && a.viewState == WorkflowListView:
+		if item, ok := a.workflowList.SelectedItem().(components.WorkflowItem); ok {
+			a.openWorkflowDiffInput(item.Workflow)
+		}
+		return a, nil
+	case msg.String() == "C" && a.viewState == WorkflowListView:
+		return a.openCachesView()
+	case msg.String() == "T" && a.viewState == WorkflowListView:
+		a.openWorkflowToggleConfirm()
+		return a, nil
+	case msg.String() == "d" && a.viewState == WorkflowListView:
+		if item, ok := a.workflowList.SelectedItem().(components.WorkflowItem); ok {
+			return a, tea.Batch(a.flashStatus("Loading workflow_dispatch inputs..."), a.loadDispatchForm(item.Workflow))
+		}
+		return a, nil
+	case key.Matches(msg, a.keyMap.TogglePreview) && a.hasPreviewPanel():
+		return a, a.togglePreview()
+	case key.Matches(msg, a.keyMap.NextTab) && a.selectedRun() != nil:
+		return a, a.cycleListDensity()
+	case msg.String() == "A" && a.selectedRun() != nil:
+		return a, a.loadApprovalDeployments(*a.selectedRun())
+	case msg.String() == "o" && a.selectedRun() != nil:
+		return a, a.openInBrowser(a.selectedRun().HTMLURL)
+	case msg.String() == "y" && a.selectedRun() != nil:
+		return a, a.copyRunSHA(a.selectedRun().HeadSha)
+	case msg.String() == "f" && a.currentListFilter() != nil:
+		a.listFilterInputMode = true
+		*a.currentListFilter() = listFilter{}
+		a.applyListFilter()
+		return a, nil
 	case key.Matches(msg, a.keyMap.Enter):
 		return a.handleEnter()
 	case key.Matches(msg, a.keyMap.Refresh):
@@ -600,7 +1698,7 @@ func (a *App) switchToWorkflowsView() (tea.Model, tea.Cmd) {
 
 // switchToAllRunsView switches to the all runs view
 func (a *App) switchToAllRunsView() (tea.Model, tea.Cmd) {
-	if a.viewState == WorkflowListView || a.viewState == WorkflowRunsView {
+	if a.viewState == WorkflowListView || a.viewState == WorkflowRunsView || a.viewState == BranchRunsView || a.viewState == PRRunsView {
 		a.viewState = AllRunsView
 		a.currentWorkflow = nil
 		a.loading = true
@@ -617,12 +1715,7 @@ func (a *App) handleEnter() (tea.Model, tea.Cmd) {
 			return a, nil // No runs available
 		}
 		if item, ok := a.allRunsList.SelectedItem().(components.WorkflowRunItem); ok {
-			a.currentRun = &item.Run
-			a.viewState = WorkflowRunLogsView
-			a.loading = true
-			a.logOffset = 0
-			a.logs = ""
-			return a, a.loadWorkflowRunLogs(item.Run.ID)
+			return a.openRunLogs(item.Run)
 		}
 	case WorkflowListView:
 		if len(a.workflows) == 0 {
@@ -631,6 +1724,8 @@ func (a *App) handleEnter() (tea.Model, tea.Cmd) {
 		if item, ok := a.workflowList.SelectedItem().(components.WorkflowItem); ok {
 			a.currentWorkflow = &item.Workflow
 			a.viewState = WorkflowRunsView
+			a.workflowRunsPage = 1
+			a.workflowRunsTotal = 0
 			a.loading = true
 			return a, a.loadWorkflowRuns(item.Workflow.ID)
 		}
@@ -639,18 +1734,47 @@ func (a *App) handleEnter() (tea.Model, tea.Cmd) {
 			return a, nil // No workflow runs available
 		}
 		if item, ok := a.runsList.SelectedItem().(components.WorkflowRunItem); ok {
-			a.currentRun = &item.Run
-			a.viewState = WorkflowRunLogsView
-			a.loading = true
-			a.logOffset = 0
-			a.logs = ""
-			return a, a.loadWorkflowRunLogs(item.Run.ID)
+			return a.openRunLogs(item.Run)
+		}
+	case BranchRunsView:
+		if len(a.branchRuns) == 0 {
+			return a, nil // No runs available
+		}
+		if item, ok := a.branchRunsList.SelectedItem().(components.WorkflowRunItem); ok {
+			return a.openRunLogs(item.Run)
+		}
+	case PRRunsView:
+		if len(a.prRuns) == 0 {
+			return a, nil // No runs available
+		}
+		if item, ok := a.prRunsList.SelectedItem().(components.WorkflowRunItem); ok {
+			return a.openRunLogs(item.Run)
 		}
 	}
 
 	return a, nil
 }
 
+// openRunLogs switches to the logs view for the given run
+func (a *App) openRunLogs(run models.WorkflowRun) (tea.Model, tea.Cmd) {
+	a.currentRun = &run
+	a.logsParentView = a.viewState
+	a.viewState = WorkflowRunLogsView
+	a.loading = true
+	a.logOffset = 0
+	a.logCursorLine = -1
+	a.logs = ""
+	a.logSections = nil
+	a.stepSections = nil
+	a.logDisplayLines = nil
+	a.jobLog = nil
+	a.logTail = false
+	a.runAnnotations = nil
+	a.logAnnotations = nil
+	a.annotationDetail = nil
+	return a, tea.Batch(a.loadWorkflowRunLogs(run), a.loadWorkflowRunJobs(run.ID), a.loadRunAnnotations(run.ID))
+}
+
 // goBack handles the back action
 func (a *App) goBack() (tea.Model, tea.Cmd) {
 	switch a.viewState {
@@ -661,12 +1785,26 @@ func (a *App) goBack() (tea.Model, tea.Cmd) {
 		a.viewState = WorkflowListView
 		return a, nil
 	case WorkflowRunLogsView:
-		if a.currentWorkflow != nil {
-			a.viewState = WorkflowRunsView
-		} else {
-			a.viewState = AllRunsView
-		}
+		a.viewState = a.logsParentView
+		return a, nil
+	case ArtifactsView:
+		a.viewState = WorkflowRunLogsView
+		return a, nil
+	case DebugLogView:
+		return a.closeDebugLogView()
+	case CachesView:
+		a.viewState = WorkflowListView
 		return a, nil
+	case BranchRunsView:
+		a.viewState = AllRunsView
+		a.branchFilter = ""
+		a.branchRuns = nil
+		return a, a.loadSelectedRunJobs()
+	case PRRunsView:
+		a.viewState = AllRunsView
+		a.prNumber = 0
+		a.prRuns = nil
+		return a, a.loadSelectedRunJobs()
 	}
 
 	return a, nil
@@ -680,18 +1818,31 @@ func (a *App) refresh() (tea.Model, tea.Cmd) {
 	case AllRunsView:
 		return a, a.loadAllRunsPaginated()
 	case WorkflowListView:
+		// 実行中の件数などを最新にするため統計を取り直す
+		a.workflowStatsCache = make(map[int64]*components.WorkflowStats)
+		a.workflowScheduleCache = make(map[int64]*components.WorkflowSchedule)
 		return a, a.loadWorkflowsPaginated()
 	case WorkflowRunsView:
 		if a.currentWorkflow != nil {
 			return a, a.loadWorkflowRuns(a.currentWorkflow.ID)
 		}
+	case BranchRunsView:
+		return a, a.loadBranchRunsPaginated()
+	case PRRunsView:
+		return a, a.loadPRRunsPaginated()
 	case WorkflowRunLogsView:
+		if a.jobLog != nil {
+			delete(a.jobLogsCache, a.jobLog.ID)
+			return a, a.loadJobLogs(*a.jobLog)
+		}
 		if a.currentRun != nil {
 			a.logOffset = 0
 			a.logs = ""
+			a.stepSections = nil
+			a.logDisplayLines = nil
 			// 強制再取得のためキャッシュ削除
-			delete(a.logsCache, a.currentRun.ID)
-			return a, a.loadWorkflowRunLogs(a.currentRun.ID)
+			a.logsCache.Delete(a.currentRun.ID)
+			return a, a.loadWorkflowRunLogs(*a.currentRun)
 		}
 	}
 
@@ -710,25 +1861,52 @@ func (a *App) updateLists(msg tea.Msg) (tea.Model, tea.Cmd) {
 		cmds = append(cmds, cmd)
 
 		// If selection changed, load jobs for the new selection with debounce
-		if a.allRunsList.Index() != oldIndex && len(a.allRuns) > 0 {
-			if a.allRunsList.Index() < len(a.allRuns) {
-				selectedRun := a.allRuns[a.allRunsList.Index()]
-				a.scheduleJobsLoad(selectedRun.ID)
+		if a.allRunsList.Index() != oldIndex {
+			if selectedRun := selectedRunInList(a.allRunsList); selectedRun != nil {
+				cmds = append(cmds, a.scheduleJobsLoad(selectedRun.ID))
 			}
 		}
 	case WorkflowListView:
+		oldIndex := a.workflowList.Index()
 		a.workflowList, cmd = a.workflowList.Update(msg)
 		cmds = append(cmds, cmd)
+
+		// If selection changed, load stats for the new selection
+		// and the history of the workflows scrolled into view
+		if a.workflowList.Index() != oldIndex {
+			cmds = append(cmds, a.loadSelectedWorkflowDetails(), a.scheduleWorkflowStatsLoad())
+		}
 	case WorkflowRunsView:
 		oldIndex := a.runsList.Index()
 		a.runsList, cmd = a.runsList.Update(msg)
 		cmds = append(cmds, cmd)
 
 		// If selection changed, load jobs for the new selection with debounce
-		if a.runsList.Index() != oldIndex && len(a.workflowRuns) > 0 {
-			if a.runsList.Index() < len(a.workflowRuns) {
-				selectedRun := a.workflowRuns[a.runsList.Index()]
-				a.scheduleJobsLoad(selectedRun.ID)
+		if a.runsList.Index() != oldIndex {
+			if selectedRun := selectedRunInList(a.runsList); selectedRun != nil {
+				cmds = append(cmds, a.scheduleJobsLoad(selectedRun.ID))
+			}
+		}
+	case BranchRunsView:
+		oldIndex := a.branchRunsList.Index()
+		a.branchRunsList, cmd = a.branchRunsList.Update(msg)
+		cmds = append(cmds, cmd)
+
+		// If selection changed, load jobs for the new selection with debounce
+		if a.branchRunsList.Index() != oldIndex {
+			if selectedRun := selectedRunInList(a.branchRunsList); selectedRun != nil {
+				cmds = append(cmds, a.scheduleJobsLoad(selectedRun.ID))
+			}
+		}
+	case PRRunsView:
+		oldIndex := a.prRunsList.Index()
+		a.prRunsList, cmd = a.prRunsList.Update(msg)
+		cmds = append(cmds, cmd)
+
+		// If selection changed, load jobs for the new selection with debounce
+		if a.prRunsList.Index() != oldIndex {
+			if selectedRun := selectedRunInList(a.prRunsList); selectedRun != nil {
+				cmds = append(cmds, a.scheduleJobsLoad(selectedRun.ID))
 			}
 		}
 	}
@@ -739,24 +1917,43 @@ func (a *App) updateLists(msg tea.Msg) (tea.Model, tea.Cmd) {
 // updateListSizes updates the list sizes based on window dimensions
 func (a *App) updateListSizes() {
 	switch a.viewState {
-	case WorkflowRunsView, AllRunsView:
+	case WorkflowRunsView, AllRunsView, BranchRunsView, PRRunsView:
 		// 2-column layout for workflow runs view and all runs view
 		// Use approximately 60% for list and 40% for preview to maximize usage
 		listWidth := (a.width*3)/5 - 2 // 60% minus small margin
-		listHeight := a.height - 6
+		listHeight := a.height - 6 - breadcrumbHeight
 		previewWidth := (a.width*2)/5 - 1 // 40% minus small margin
-		previewHeight := a.height - 4
+		previewHeight := a.height - 4 - breadcrumbHeight
+		if !a.previewVisible {
+			listWidth = a.width - 4
+			previewWidth = 0
+		}
+		if a.approvalMode {
+			// Make room for the approval dialog (title + environments + help)
+			listHeight -= len(a.approvalDeployments) + 2
+		}
+		if a.eventPickerMode {
+			// Make room for the event picker (title + events + help)
+			listHeight -= len(a.eventPickerOptions) + 2
+		}
 
+		a.runDelegate.SetWidth(listWidth)
 		a.runsList.SetSize(listWidth, listHeight)
 		a.allRunsList.SetSize(listWidth, listHeight)
+		a.branchRunsList.SetSize(listWidth, listHeight)
+		a.prRunsList.SetSize(listWidth, listHeight)
 		a.previewPanel.SetSize(previewWidth, previewHeight)
 	case WorkflowListView:
 		// 2-column layout for workflow list view
 		// Use approximately 60% for list and 40% for preview to maximize usage
-		listWidth := (a.width*3)/5 - 1    // 60% minus small margin
-		listHeight := a.height - 4        // Reduce margin to show more items
-		previewWidth := (a.width*2)/5 - 1 // 40% minus small margin
-		previewHeight := a.height - 4     // Account for header and margins
+		listWidth := (a.width*3)/5 - 1                // 60% minus small margin
+		listHeight := a.height - 4 - breadcrumbHeight // Reduce margin to show more items
+		if a.dispatchInputMode {
+			// Make room for the dispatch form (title + fields + help)
+			listHeight -= len(a.dispatchFields) + 2
+		}
+		previewWidth := (a.width*2)/5 - 1                // 40% minus small margin
+		previewHeight := a.height - 4 - breadcrumbHeight // Account for header and margins
 
 		// Ensure minimum sizes to prevent display issues
 		if listWidth < 20 {
@@ -771,27 +1968,45 @@ func (a *App) updateListSizes() {
 		if previewHeight < 5 {
 			previewHeight = 5
 		}
+		if !a.previewVisible {
+			listWidth = a.width - 4
+			previewWidth = 0
+		}
 
 		a.workflowList.SetSize(listWidth, listHeight)
 		a.previewPanel.SetSize(previewWidth, previewHeight)
 	default:
 		// Full width for other views (logs view)
 		listWidth := a.width - 4
-		listHeight := a.height - 6
+		listHeight := a.height - 6 - breadcrumbHeight
 
 		a.workflowList.SetSize(listWidth, listHeight)
 		a.runsList.SetSize(listWidth, listHeight)
 		a.allRunsList.SetSize(listWidth, listHeight)
+		a.branchRunsList.SetSize(listWidth, listHeight)
+		a.prRunsList.SetSize(listWidth, listHeight)
 	}
 }
 
 // updateWorkflowList updates the workflow list items
 func (a *App) updateWorkflowList() {
-	items := make([]list.Item, len(a.workflows))
-	for i, workflow := range a.workflows {
-		items[i] = components.WorkflowItem{Workflow: workflow}
+	var scored []scoredItem
+	for _, workflow := range a.workflows {
+		score, indexes, ok := components.FuzzyMatch(a.workflowFilter.query, workflow.Name)
+		if pathScore, _, pathOK := components.FuzzyMatch(a.workflowFilter.query, workflow.Path); pathOK && (!ok || pathScore > score) {
+			score, ok = pathScore, true
+		}
+		if !ok && a.workflowFilter.hide {
+			continue
+		}
+		item := components.WorkflowItem{Workflow: workflow, MatchedIndexes: indexes}
+		if stats := a.workflowStatsCache[workflow.ID]; stats != nil {
+			item.History = stats.Conclusions
+			item.HistoryLoaded = true
+		}
+		scored = append(scored, scoredItem{item: item, score: score})
 	}
-	a.workflowList.SetItems(items)
+	a.workflowList.SetItems(sortScoredItems(scored, a.workflowFilter.hide))
 
 	// Update list title to show count
 	if len(a.workflows) == 0 {
@@ -799,13 +2014,15 @@ func (a *App) updateWorkflowList() {
 	} else {
 		a.workflowList.Title = fmt.Sprintf("Workflows (%d)", len(a.workflows))
 	}
+	a.workflowList.Title += a.workflowFilter.titleSuffix(len(scored))
 }
 
 // updateWorkflowRunsList updates the workflow runs list items
 func (a *App) updateWorkflowRunsList() {
+	sortRuns(a.workflowRuns, a.sortKey)
 	items := make([]list.Item, len(a.workflowRuns))
 	for i, run := range a.workflowRuns {
-		items[i] = components.WorkflowRunItem{Run: run}
+		items[i] = components.WorkflowRunItem{Run: run, Checked: a.selectedRuns[run.ID]}
 	}
 	a.runsList.SetItems(items)
 
@@ -819,11 +2036,24 @@ func (a *App) updateWorkflowRunsList() {
 
 // updateAllRunsList updates the all runs list items
 func (a *App) updateAllRunsList() {
-	items := make([]list.Item, len(a.allRuns))
-	for i, run := range a.allRuns {
-		items[i] = components.WorkflowRunItem{Run: run}
+	sortRuns(a.allRuns, a.sortKey)
+	var scored []scoredItem
+	for _, run := range a.allRuns {
+		score, indexes, ok := components.FuzzyMatch(a.allRunsFilter.query, run.Name)
+		for _, field := range []string{run.HeadBranch, run.Actor.Login, run.HeadCommit.Message} {
+			if fieldScore, _, fieldOK := components.FuzzyMatch(a.allRunsFilter.query, field); fieldOK && (!ok || fieldScore > score) {
+				score, ok = fieldScore, true
+			}
+		}
+		if !ok && a.allRunsFilter.hide {
+			continue
+		}
+		scored = append(scored, scoredItem{
+			item:  components.WorkflowRunItem{Run: run, MatchedIndexes: indexes, Checked: a.selectedRuns[run.ID], Dimmed: !a.matchesCommitSearch(run)},
+			score: score,
+		})
 	}
-	a.allRunsList.SetItems(items)
+	a.allRunsList.SetItems(sortScoredItems(scored, a.allRunsFilter.hide))
 
 	// Update list title to show count
 	if len(a.allRuns) == 0 {
@@ -831,13 +2061,113 @@ func (a *App) updateAllRunsList() {
 	} else {
 		a.allRunsList.Title = fmt.Sprintf("All Workflow Runs (%d)", len(a.allRuns))
 	}
+	a.allRunsList.Title += a.allRunsFilter.titleSuffix(len(scored))
+}
+
+// scoredItem represents a list item with its fuzzy filter score
+type scoredItem struct {
+	item  list.Item
+	score int
+}
+
+// sortScoredItems returns list items, ordered by score when sorted is true
+func sortScoredItems(scored []scoredItem, sorted bool) []list.Item {
+	if sorted {
+		sort.SliceStable(scored, func(i, j int) bool {
+			return scored[i].score > scored[j].score
+		})
+	}
+	items := make([]list.Item, len(scored))
+	for i, s := range scored {
+		items[i] = s.item
+	}
+	return items
+}
+
+// titleSuffix returns the list title suffix describing the active filter
+func (f listFilter) titleSuffix(matched int) string {
+	if f.query == "" || !f.hide {
+		return ""
+	}
+	return fmt.Sprintf(" [filter: %s, %d matched]", f.query, matched)
+}
+
+// renderListFilterPrompt renders the fuzzy filter prompt for the current list view
+func (a *App) renderListFilterPrompt() string {
+	filter := a.currentListFilter()
+	if filter == nil {
+		return ""
+	}
+	if a.listFilterInputMode {
+		return a.styles.GetHelp().Render("filter: " + filter.query + "_  (Enter: confirm, Esc: reset)")
+	}
+	if filter.query != "" {
+		return a.styles.GetHelp().Render("filter: " + filter.query + "  (Esc: reset)")
+	}
+	return ""
+}
+
+// currentListFilter returns the filter of the current list view, or nil if the view has no filter
+func (a *App) currentListFilter() *listFilter {
+	switch a.viewState {
+	case WorkflowListView:
+		return &a.workflowFilter
+	case AllRunsView:
+		return &a.allRunsFilter
+	}
+	return nil
+}
+
+// applyListFilter rebuilds the current list with the filter applied
+func (a *App) applyListFilter() {
+	switch a.viewState {
+	case WorkflowListView:
+		a.workflowList.ResetSelected()
+		a.updateWorkflowList()
+	case AllRunsView:
+		a.allRunsList.ResetSelected()
+		a.updateAllRunsList()
+	}
+}
+
+// selectedRunInList returns the workflow run selected in the given list
+func selectedRunInList(l list.Model) *models.WorkflowRun {
+	if item, ok := l.SelectedItem().(components.WorkflowRunItem); ok {
+		return &item.Run
+	}
+	return nil
+}
+
+// updateBranchRunsList updates the branch runs list items
+func (a *App) updateBranchRunsList() {
+	items := make([]list.Item, len(a.branchRuns))
+	for i, run := range a.branchRuns {
+		items[i] = components.WorkflowRunItem{Run: run}
+	}
+	a.branchRunsList.SetItems(items)
+
+	// Update list title to show count
+	if len(a.branchRuns) == 0 {
+		a.branchRunsList.Title = fmt.Sprintf("Workflow Runs on %s (No runs found)", a.branchFilter)
+	} else {
+		a.branchRunsList.Title = fmt.Sprintf("Workflow Runs on %s (%d)", a.branchFilter, len(a.branchRuns))
+	}
 }
 
 // renderWorkflowListView renders the workflow list view
 func (a *App) renderWorkflowListView() string {
-	header := a.styles.GetTitle().Render(fmt.Sprintf("GitHub Actions - %s/%s", a.owner, a.repo))
+	headerText := fmt.Sprintf("GitHub Actions - %s/%s", a.owner, a.repo)
+	// 選択中のワークフローの実行中・待機中の件数
+	if item, ok := a.workflowList.SelectedItem().(components.WorkflowItem); ok {
+		if stats := a.workflowStatsCache[item.Workflow.ID]; stats != nil {
+			if counts := components.FormatActiveRuns(stats.Running, stats.Queued); counts != "" {
+				headerText += " " + counts
+			}
+		}
+	}
+	header := a.styles.GetTitle().Render(headerText) + a.paginationSpinner()
 
-	help := a.styles.GetHelp().Render("Enter: View runs • a: All runs • r: Refresh • n: Next page • p: Prev page • q: Quit")
+	help := a.styles.GetHelp().Render("Enter: View runs • a: All runs • d: Dispatch • D: Diff • T: Enable/Disable • C: Caches • f: Filter • r: Refresh • n: Next page • p: Prev page • ?: Help • q: Quit")
 
 	// Pagination info
 	paginationInfo := ""
@@ -848,64 +2178,47 @@ func (a *App) renderWorkflowListView() string {
 	// Left side - workflow list
 	var leftMainContent string
 	if len(a.workflows) == 0 {
-		emptyMessage := a.styles.GetHelp().Render("📋 このリポジトリにはGitHub Actions ワークフローがありません")
-		emptyDetails := a.styles.GetHelp().Render("💡 .github/workflows/ ディレクトリにワークフローファイルを作成してください")
-		leftMainContent = lipgloss.JoinVertical(
-			lipgloss.Left,
-			"",
-			emptyMessage,
-			emptyDetails,
-			"",
+		leftMainContent = a.renderEmptyList(
+			"📋 このリポジトリにはGitHub Actions ワークフローがありません",
+			"💡 .github/workflows/ ディレクトリにワークフローファイルを作成してください",
 		)
 	} else {
-		listView := a.workflowList.View()
-		leftMainContent = listView
+		leftMainContent = a.workflowList.View()
 	}
 
-	leftContentParts := []string{header, leftMainContent}
-	if paginationInfo != "" {
-		leftContentParts = append(leftContentParts, paginationInfo)
-	}
-	leftContentParts = append(leftContentParts, help)
-
-	leftContent := lipgloss.JoinVertical(
-		lipgloss.Left,
-		leftContentParts...,
-	)
-
 	// Right side - preview panel
 	var selectedWorkflow *models.Workflow
-	if len(a.workflows) > 0 && a.workflowList.Index() < len(a.workflows) {
-		selectedWorkflow = &a.workflows[a.workflowList.Index()]
+	if item, ok := a.workflowList.SelectedItem().(components.WorkflowItem); ok {
+		selectedWorkflow = &item.Workflow
 	}
 
-	rightContent := a.previewPanel.RenderWorkflowPreview(selectedWorkflow)
-
-	// Create a container that places preview panel at the right edge
-	previewWidth := (a.width * 2) / 5
-	leftWidth := a.width - previewWidth
-
-	// Ensure left content takes up the remaining space
-	leftContainer := lipgloss.NewStyle().Width(leftWidth).Render(leftContent)
-
-	// Right align the preview panel at the edge
-	rightContainer := lipgloss.NewStyle().Width(previewWidth).AlignHorizontal(lipgloss.Right).Render(rightContent)
-
-	mainContent := lipgloss.JoinHorizontal(
-		lipgloss.Top,
-		leftContainer,
-		rightContainer,
-	)
+	var stats *components.WorkflowStats
+	var schedule *components.WorkflowSchedule
+	if selectedWorkflow != nil {
+		stats = a.workflowStatsCache[selectedWorkflow.ID]
+		schedule = a.workflowScheduleCache[selectedWorkflow.ID]
+	}
+	rightContent := a.previewPanel.RenderWorkflowPreview(selectedWorkflow, stats, schedule, a.cacheUsage)
 
-	return a.styles.Base.Render(mainContent)
+	return a.renderTwoColumnLayout(a.buildLeftContent(header, leftMainContent, paginationInfo, help), rightContent)
 }
 
 // renderAllRunsView renders the all runs view (time-ordered)
 func (a *App) renderAllRunsView() string {
 	headerText := fmt.Sprintf("All Workflow Runs - %s/%s", a.owner, a.repo)
-	header := a.styles.GetTitle().Render(headerText)
+	if label := a.allRunsFilterLabel(); label != "" {
+		headerText = fmt.Sprintf("All Workflow Runs (%s) - %s/%s", label, a.owner, a.repo)
+	}
+	if a.commitSearchQuery != "" {
+		headerText += fmt.Sprintf(" [commit: %s]", a.commitSearchQuery)
+	}
+	headerText += a.runSortLabel()
+	if counts := components.FormatActiveRuns(components.CountActiveRuns(a.allRuns)); counts != "" {
+		headerText += " " + counts
+	}
+	header := a.styles.GetTitle().Render(headerText) + a.paginationSpinner()
 
-	help := a.styles.GetHelp().Render("Enter: View logs • w: Workflows • r: Refresh • n: Next page • p: Prev page • q: Quit")
+	help := a.styles.GetHelp().Render("Enter: View logs • Space: Select • w: Workflows • b: Branch • e: Event • @: Actor • c: Commit • f: Filter • r: Refresh • n: Next page • p: Prev page • ?: Help • q: Quit")
 
 	// Pagination info
 	paginationInfo := ""
@@ -916,137 +2229,229 @@ func (a *App) renderAllRunsView() string {
 	// Left side - all runs list
 	var leftMainContent string
 	if len(a.allRuns) == 0 {
-		emptyMessage := a.styles.GetHelp().Render("📋 このリポジトリには実行されたワークフローがありません")
-		emptyDetails := a.styles.GetHelp().Render("💡 ワークフローを実行するか、トリガー条件を満たしてください")
-		leftMainContent = lipgloss.JoinVertical(
-			lipgloss.Left,
-			"",
-			emptyMessage,
-			emptyDetails,
-			"",
+		leftMainContent = a.renderEmptyList(
+			"📋 このリポジトリには実行されたワークフローがありません",
+			"💡 ワークフローを実行するか、トリガー条件を満たしてください",
 		)
 	} else {
-		// Add table header
-		tableHeader := a.styles.GetHelp().Render("Name                     Status         Branch             Actor           PR           Duration Time")
-		listView := a.allRunsList.View()
-		leftMainContent = lipgloss.JoinVertical(
-			lipgloss.Left,
-			tableHeader,
-			listView,
-		)
-	}
-
-	leftContentParts := []string{header, leftMainContent}
-	if paginationInfo != "" {
-		leftContentParts = append(leftContentParts, paginationInfo)
+		leftMainContent = a.renderRunsTable(a.allRunsList)
 	}
-	leftContentParts = append(leftContentParts, help)
-
-	leftContent := lipgloss.JoinVertical(
-		lipgloss.Left,
-		leftContentParts...,
-	)
 
 	// Right side - preview panel
-	var selectedRun *models.WorkflowRun
-	if len(a.allRuns) > 0 && a.allRunsList.Index() < len(a.allRuns) {
-		selectedRun = &a.allRuns[a.allRunsList.Index()]
-	}
+	rightContent := a.previewPanel.RenderWorkflowRunPreview(a.withRunUsage(a.withPendingDeployments(selectedRunInList(a.allRunsList))), a.currentJobs)
 
-	rightContent := a.previewPanel.RenderWorkflowRunPreview(selectedRun, a.currentJobs)
-
-	// Create a container that places preview panel at the right edge
-	previewWidth := (a.width * 2) / 5
-	leftWidth := a.width - previewWidth
-
-	// Ensure left content takes up the remaining space
-	leftContainer := lipgloss.NewStyle().Width(leftWidth).Render(leftContent)
-
-	// Right align the preview panel at the edge
-	rightContainer := lipgloss.NewStyle().Width(previewWidth).AlignHorizontal(lipgloss.Right).Render(rightContent)
-
-	mainContent := lipgloss.JoinHorizontal(
-		lipgloss.Top,
-		leftContainer,
-		rightContainer,
-	)
-
-	return a.styles.Base.Render(mainContent)
+	return a.renderTwoColumnLayout(a.buildLeftContent(header, leftMainContent, paginationInfo, help), rightContent)
 }
 
 // renderWorkflowRunsView renders the workflow runs view
 func (a *App) renderWorkflowRunsView() string {
 	title := fmt.Sprintf("Workflow Runs - %s", a.currentWorkflow.Name)
-	header := a.styles.GetTitle().Render(title)
+	if a.hasRunFilter() {
+		title += fmt.Sprintf(" (%s)", a.runFilterLabel())
+	}
+	title += a.runSortLabel()
+	header := a.styles.GetTitle().Render(title) + a.paginationSpinner()
+
+	help := a.styles.GetHelp().Render("Enter: View logs • Space: Select • Esc: Back • a: All runs • r: Refresh • n: Next page • p: Prev page • ?: Help • q: Quit")
 
-	help := a.styles.GetHelp().Render("Enter: View logs • Esc: Back • a: All runs • r: Refresh • q: Quit")
+	// Pagination info
+	paginationInfo := ""
+	if a.workflowRunsTotal > 0 {
+		paginationInfo = a.styles.GetHelp().Render(a.getPaginationInfo(a.workflowRunsPage, a.workflowRunsTotal, a.workflowRunsPerPage))
+	}
 
 	// Left side - workflow runs list
 	var leftMainContent string
 	if len(a.workflowRuns) == 0 {
-		emptyMessage := a.styles.GetHelp().Render("📋 このワークフローには実行履歴がありません")
-		emptyDetails := a.styles.GetHelp().Render("💡 ワークフローを手動実行するか、トリガー条件を満たしてください")
-		leftMainContent = lipgloss.JoinVertical(
-			lipgloss.Left,
-			"",
-			emptyMessage,
-			emptyDetails,
-			"",
+		leftMainContent = a.renderEmptyList(
+			"📋 このワークフローには実行履歴がありません",
+			"💡 ワークフローを手動実行するか、トリガー条件を満たしてください",
 		)
 	} else {
-		// Add table header
-		tableHeader := a.styles.GetHelp().Render("Name                     Status         Branch             Actor           PR           Duration Time")
-		listView := a.runsList.View()
-		leftMainContent = lipgloss.JoinVertical(
-			lipgloss.Left,
-			tableHeader,
-			listView,
-		)
+		leftMainContent = a.renderRunsTable(a.runsList)
 	}
 
-	leftContent := lipgloss.JoinVertical(
-		lipgloss.Left,
-		header,
-		leftMainContent,
-		help,
-	)
-
 	// Right side - preview panel
-	var selectedRun *models.WorkflowRun
-	if len(a.workflowRuns) > 0 && a.runsList.Index() < len(a.workflowRuns) {
-		selectedRun = &a.workflowRuns[a.runsList.Index()]
+	rightContent := a.previewPanel.RenderWorkflowRunPreview(a.withRunUsage(a.withPendingDeployments(selectedRunInList(a.runsList))), a.currentJobs)
+
+	return a.renderTwoColumnLayout(a.buildLeftContent(header, leftMainContent, paginationInfo, help), rightContent)
+}
+
+// renderBranchRunsView renders the runs view filtered by branch
+func (a *App) renderBranchRunsView() string {
+	branchLabel := "branch: " + a.branchFilter
+	if a.hasRunFilter() {
+		branchLabel += ", " + a.runFilterLabel()
 	}
+	headerText := fmt.Sprintf("Workflow Runs (%s) - %s/%s", branchLabel, a.owner, a.repo)
+	header := a.styles.GetTitle().Render(headerText) + a.paginationSpinner()
 
-	rightContent := a.previewPanel.RenderWorkflowRunPreview(selectedRun, a.currentJobs)
+	help := a.styles.GetHelp().Render("Enter: View logs • Esc: Back • a: All runs • r: Refresh • n: Next page • p: Prev page • ?: Help • q: Quit")
 
-	// Create a container that places preview panel at the right edge
-	previewWidth := (a.width * 2) / 5
-	leftWidth := a.width - previewWidth
+	// Pagination info
+	paginationInfo := ""
+	if a.branchRunsTotal > 0 {
+		paginationInfo = a.styles.GetHelp().Render(a.getPaginationInfo(a.branchRunsPage, a.branchRunsTotal, a.allRunsPerPage))
+	}
+
+	// Left side - branch runs list
+	var leftMainContent string
+	if len(a.branchRuns) == 0 {
+		leftMainContent = a.renderEmptyList(
+			fmt.Sprintf("📋 ブランチ %s には実行されたワークフローがありません", a.branchFilter),
+			"💡 ブランチ名が正しいことを確認してください",
+		)
+	} else {
+		leftMainContent = a.renderRunsTable(a.branchRunsList)
+	}
 
-	// Ensure left content takes up the remaining space
-	leftContainer := lipgloss.NewStyle().Width(leftWidth).Render(leftContent)
+	// Right side - preview panel
+	rightContent := a.previewPanel.RenderWorkflowRunPreview(a.withRunUsage(a.withPendingDeployments(selectedRunInList(a.branchRunsList))), a.currentJobs)
 
-	// Right align the preview panel at the edge
-	rightContainer := lipgloss.NewStyle().Width(previewWidth).AlignHorizontal(lipgloss.Right).Render(rightContent)
+	return a.renderTwoColumnLayout(a.buildLeftContent(header, leftMainContent, paginationInfo, help), rightContent)
+}
 
-	mainContent := lipgloss.JoinHorizontal(
-		lipgloss.Top,
-		leftContainer,
-		rightContainer,
+// renderEmptyList renders the placeholder shown when a list has no items
+func (a *App) renderEmptyList(message, details string) string {
+	return lipgloss.JoinVertical(
+		lipgloss.Left,
+		"",
+		a.styles.GetHelp().Render(message),
+		a.styles.GetHelp().Render(details),
+		"",
 	)
+}
 
-	return a.styles.Base.Render(mainContent)
+// renderRunsTable renders a workflow run list with its table header
+func (a *App) renderRunsTable(l list.Model) string {
+	tableHeader := a.styles.GetHelp().Render(a.runDelegate.RunListHeader())
+	return lipgloss.JoinVertical(
+		lipgloss.Left,
+		tableHeader,
+		l.View(),
+	)
 }
 
-// renderWorkflowRunLogsView renders the workflow run logs view
-func (a *App) renderWorkflowRunLogsView() string {
-	if a.currentRun == nil {
-		return "No run selected"
+// buildLeftContent stacks the header, list, pagination info, input prompt and help of a list view
+func (a *App) buildLeftContent(header, mainContent, paginationInfo, help string) string {
+	leftContentParts := []string{header, mainContent}
+	if len(a.selectedRuns) > 0 && a.selectableRunsList() != nil {
+		// 選択中はページ情報の代わりに選択件数と一括操作を表示する
+		paginationInfo = a.styles.SelectionBar.Render(fmt.Sprintf("%d runs selected • X: cancel all • R: rerun all • Esc: clear selection", len(a.selectedRuns)))
 	}
+	if paginationInfo != "" {
+		leftContentParts = append(leftContentParts, paginationInfo)
+	}
+	if prompt := a.renderListFilterPrompt(); prompt != "" {
+		leftContentParts = append(leftContentParts, prompt)
+	}
+	if a.branchInputMode {
+		cancel := "Esc to cancel"
+		if a.defaultBranchFilter != "" && a.viewState == AllRunsView {
+			cancel = "Esc to show all branches"
+		}
+		leftContentParts = append(leftContentParts, a.styles.GetHelp().Render("branch: "+a.branchInputBuffer+"_  (Enter to filter / "+cancel+")"))
+	}
+	if a.workflowToggleTarget != nil {
+		leftContentParts = append(leftContentParts, a.renderWorkflowToggleConfirm())
+	}
+	if a.prInputMode {
+		leftContentParts = append(leftContentParts, a.styles.GetHelp().Render("PR #: "+a.prInputBuffer+"_  (Enter to show runs / Esc to cancel)"))
+	}
+	if a.actorInputMode {
+		leftContentParts = append(leftContentParts, a.renderActorInput())
+	}
+	if a.commitSearchInputMode {
+		leftContentParts = append(leftContentParts, a.styles.GetHelp().Render("commit: "+a.commitSearchBuffer+"_  (Enter to search / Esc to cancel)"))
+	}
+	if a.diffInputMode {
+		label := "base ref"
+		if a.diffBaseRef != "" {
+			label = fmt.Sprintf("head ref (base: %s)", a.diffBaseRef)
+		}
+		leftContentParts = append(leftContentParts, a.styles.GetHelp().Render("diff "+label+": "+a.diffInputBuffer+"_  (Enter to confirm / Esc to cancel)"))
+	}
+	if a.dispatchInputMode {
+		leftContentParts = append(leftContentParts, a.renderDispatchForm())
+	}
+	if a.approvalMode {
+		leftContentParts = append(leftContentParts, a.renderApprovalDialog())
+	}
+	if a.eventPickerMode {
+		leftContentParts = append(leftContentParts, a.renderEventPicker())
+	}
+	if a.statusMessage != "" {
+		leftContentParts = append(leftContentParts, a.styles.StatusSuccess.Render(a.statusMessage))
+	}
+	leftContentParts = append(leftContentParts, help)
+
+	return lipgloss.JoinVertical(
+		lipgloss.Left,
+		leftContentParts...,
+	)
+}
+
+// renderTwoColumnLayout places the list on the left and the preview panel at the right edge
+func (a *App) renderTwoColumnLayout(leftContent, rightContent string) string {
+	var mainContent string
+	if a.previewVisible {
+		// Create a container that places preview panel at the right edge
+		previewWidth := (a.width * 2) / 5
+		leftWidth := a.width - previewWidth
+
+		// Ensure left content takes up the remaining space
+		leftContainer := lipgloss.NewStyle().Width(leftWidth).Render(leftContent)
+
+		// Right align the preview panel at the edge
+		rightContainer := lipgloss.NewStyle().Width(previewWidth).AlignHorizontal(lipgloss.Right).Render(rightContent)
+
+		mainContent = lipgloss.JoinHorizontal(
+			lipgloss.Top,
+			leftContainer,
+			rightContainer,
+		)
+	} else {
+		mainContent = lipgloss.NewStyle().Width(a.width).Render(leftContent)
+	}
+
+	if indicator := a.renderRateLimit(); indicator != "" {
+		mainContent = lipgloss.JoinVertical(
+			lipgloss.Left,
+			mainContent,
+			lipgloss.NewStyle().Width(a.width).AlignHorizontal(lipgloss.Right).Render(indicator),
+		)
+	}
+
+	return a.styles.Base.Render(mainContent)
+}
+
+// renderRateLimit renders the API rate-limit usage indicator
+func (a *App) renderRateLimit() string {
+	rateLimit, ok := a.client.RateLimit()
+	if !ok || rateLimit.Limit == 0 {
+		return ""
+	}
+
+	text := fmt.Sprintf("API: %d/%d", rateLimit.Remaining, rateLimit.Limit)
+	// 残りが10%を切ったら赤で警告する
+	if rateLimit.Remaining*10 < rateLimit.Limit {
+		return a.styles.StatusFailure.Render(text)
+	}
+	return a.styles.GetHelp().Render(text)
+}
+
+// renderWorkflowRunLogsView renders the workflow run logs view
+func (a *App) renderWorkflowRunLogsView() string {
+	if a.currentRun == nil {
+		return "No run selected"
+	}
+
+	title := fmt.Sprintf("Logs - Run #%d", a.currentRun.RunNumber)
+	if a.jobLog != nil {
+		title += " - Job: " + a.jobLog.Name
+	}
+	header := a.styles.GetTitle().Render(title)
 
-	title := fmt.Sprintf("Logs - Run #%d", a.currentRun.RunNumber)
-	header := a.styles.GetTitle().Render(title)
-
 	if a.logs == "" {
 		return lipgloss.JoinVertical(
 			lipgloss.Left,
@@ -1057,19 +2462,43 @@ func (a *App) renderWorkflowRunLogsView() string {
 
 	// Split logs into lines for scrolling
 	lines := strings.Split(a.logs, "\n")
-	viewHeight := a.height - 6 // Account for header and help
+	viewHeight := a.height - 6 - breadcrumbHeight // Account for breadcrumb, header and help
+	// 失敗したランはヘッダーの下に最初の失敗までの時間を表示する
+	if firstFailure := a.renderFirstFailure(); firstFailure != "" {
+		header = lipgloss.JoinVertical(lipgloss.Left, header, firstFailure)
+		viewHeight--
+	}
+	if a.approvalMode {
+		// 承認ダイアログの行数分だけ表示行を減らす
+		viewHeight -= len(a.approvalDeployments)
+	}
+	if a.bookmarkMode {
+		// ブックマーク一覧の行数分だけ表示行を減らす
+		viewHeight -= len(a.bookmarks.Lines(a.currentRun.ID))
+	}
+	var annotationDetail string
+	if a.annotationDetail != nil {
+		// アノテーション詳細の行数分だけ表示行を減らす
+		annotationDetail = a.renderAnnotationDetail()
+		viewHeight -= lipgloss.Height(annotationDetail) - 1
+	}
 
-	// Calculate visible lines
+	// Calculate visible rows (collapsed step sections show only their header)
+	displayCount := a.logDisplayCount(len(lines))
 	start := a.logOffset
 	end := start + viewHeight
-	if end > len(lines) {
-		end = len(lines)
+	if end > displayCount {
+		end = displayCount
 	}
-	if start > len(lines) {
-		start = len(lines)
+	if start > displayCount {
+		start = displayCount
 	}
 
-	visibleLines := lines[start:end]
+	visibleLines := make([]string, 0, end-start)
+	for row := start; row < end; row++ {
+		visibleLines = append(visibleLines, lines[a.logLineAtRow(row)])
+	}
+	detectedURLs := a.detectLogURLs(start, visibleLines)
 
 	highlightedLines := make([]string, len(visibleLines))
 	lineNumberWidth := len(fmt.Sprintf("%d", len(lines))) // 桁数揃え
@@ -1082,38 +2511,61 @@ func (a *App) renderWorkflowRunLogsView() string {
 	if sepLen < 10 {
 		sepLen = 10
 	}
-	sepStr := strings.Repeat("─", sepLen)
 
 	// 検索ワードハイライト用
-	var searchQuery string
-	if a.searchInputMode && a.searchInputBuffer != "" {
-		searchQuery = a.searchInputBuffer
-	} else if a.searchActiveQuery != "" {
-		searchQuery = a.searchActiveQuery
+	var matchLine func(string) []int
+	var searchErr error
+	if searchQuery := a.currentSearchQuery(); searchQuery != "" {
+		matchLine, searchErr = a.logSearchMatcher(searchQuery)
+	}
+
+	// ジョブごとに行番号を色分けする
+	jobForFile := make(map[string]int)
+	for _, section := range a.logSections {
+		jobForFile[section.FileName] = jobIndexForLogFile(section.FileName, a.currentJobs)
 	}
 
 	for i, line := range visibleLines {
-		lineNum := start + i + 1
+		lineIndex := a.logLineAtRow(start + i)
+		lineNum := lineIndex + 1
 		// 行番号をつける
 		prefix := fmt.Sprintf("%*d | ", lineNumberWidth, lineNum)
+		if section := findLogSection(a.logSections, lineIndex); section != nil {
+			if jobIndex := jobForFile[section.FileName]; jobIndex >= 0 {
+				prefix = lipgloss.NewStyle().Foreground(jobColors[jobIndex%len(jobColors)]).Render(prefix)
+			}
+		}
+		if len(a.logAnnotations) > 0 {
+			prefix = a.annotationGutter(lineIndex) + prefix
+		}
 
 		trimmed := strings.TrimSpace(line)
 		if strings.Contains(trimmed, stepGroupPrefix) {
-			sep := lipgloss.NewStyle().Foreground(lipgloss.Color("36")).Bold(true).Render(sepStr)
+			// タイムスタンプの後ろにある##[group]以降をステップ名として区切り線に表示する
+			_, stepName, _ := strings.Cut(trimmed, stepGroupMarker)
+			sep := lipgloss.NewStyle().Foreground(lipgloss.Color("36")).Bold(true).Render(stepSeparator(stepName, sepLen, a.width-20))
 			highlightedLines = append(highlightedLines, sep)
 		}
 
 		// 検索ワードがあれば黄色でハイライト
 		renderedLine := a.applySimpleHighlight(line)
-		if searchQuery != "" {
-			idx := strings.Index(strings.ToLower(renderedLine), strings.ToLower(searchQuery))
-			if idx >= 0 {
-				before := renderedLine[:idx]
-				match := renderedLine[idx : idx+len(searchQuery)]
-				after := renderedLine[idx+len(searchQuery):]
-				match = lipgloss.NewStyle().Foreground(lipgloss.Color("226")).Bold(true).Render(match)
-				renderedLine = before + match + after
+		if _, ok := detectedURLs[lineIndex]; ok {
+			renderedLine = underlineLogURLs(renderedLine)
+		}
+		if a.isSearchContextLine(lineIndex) {
+			renderedLine = a.styles.SearchContext.Render(logs.StripANSI(line))
+		} else if matchLine != nil {
+			if highlighted := highlightSearchMatch(line, matchLine); highlighted != line {
+				renderedLine = highlighted
+			}
+		}
+		// ステップセクションのヘッダーに開閉状態を表示する
+		if section := a.stepSectionAt(lineIndex); section != nil && section.Start == lineIndex {
+			indicator := "[-] "
+			if section.Collapsed {
+				indicator = "[+] "
 			}
+			renderedLine = a.styles.HelpKey.Render(indicator) + renderedLine
 		}
 		highlightedLines = append(highlightedLines, prefix+renderedLine)
 	}
@@ -1122,14 +2574,40 @@ func (a *App) renderWorkflowRunLogsView() string {
 	// Prompt for search/jump input mode
 	var inputPrompt string
 	if a.searchInputMode {
-		inputPrompt = a.styles.GetHelp().Render("/" + a.searchInputBuffer + "_  (Enter: search, n/N: next/prev match, Esc: reset)")
+		inputPrompt = a.renderSearchInputPrompt(searchErr)
 	} else if a.jumpInputMode {
 		inputPrompt = a.styles.GetHelp().Render(":" + a.jumpInputBuffer + "_  (Enter to jump / Esc to cancel)")
+	} else if a.approvalMode {
+		inputPrompt = a.renderApprovalDialog()
+	} else if a.annotationDetail != nil {
+		inputPrompt = annotationDetail
+	} else if a.bookmarkMode {
+		inputPrompt = a.renderBookmarkList()
+	} else if a.saveInputMode {
+		inputPrompt = a.styles.GetHelp().Render("Save to: " + a.saveInputBuffer + "_  (Enter to save / Esc to cancel)")
+	} else if a.statusMessage != "" {
+		inputPrompt = a.styles.StatusSuccess.Render(a.statusMessage)
 	} else if a.searchActiveQuery != "" {
-		inputPrompt = a.styles.GetHelp().Render("n/N: next/prev match, Esc: reset")
+		if a.searchInvert {
+			inputPrompt = a.styles.GetHelp().Render(fmt.Sprintf("[NOT /%s/] %d lines hidden  (!: show all lines, Esc: reset)", a.searchActiveQuery, len(a.searchMatchIndices)))
+		} else {
+			inputPrompt = a.styles.GetHelp().Render(fmt.Sprintf("n/N: next/prev match, +/-: context lines (%d), !: invert, Esc: reset", a.searchContextLines))
+		}
+	}
+
+	help := a.styles.GetHelp().Render("↑/↓: Scroll • PageUp/PageDown: Page UpDown • g/G: Top/Bottom • Enter: Expand/Collapse step • q: Quit • / to search :n to jump・ f|→: View workflow file • s: Save logs • y/Y: Copy line/screen • c: Copy SHA • B/b: Bookmark/List • J: Jobs • E: Env names • a: Artifacts • o: Open in browser • ?: Help")
+
+	if a.cursorLogURL(lines, viewHeight) != "" {
+		help = a.styles.GetHelp().Render("o: open URL • ↑/↓: Scroll • / to search :n to jump • ?: Help")
 	}
 
-	help := a.styles.GetHelp().Render("↑/↓: Scroll • PageUp/PageDown: Page UpDown • g/G: Top/Bottom • q: Quit • / to search :n to jump・ f|→: View workflow file")
+	if a.showJobSidebar {
+		help = a.styles.GetHelp().Render("↑/↓: Select job • Enter: Jump to job / Expand matrix • l: Job log only • J/Esc: Close")
+		content = lipgloss.JoinHorizontal(lipgloss.Top, a.renderJobSidebar(viewHeight), content)
+	}
+	if a.showRunMeta {
+		content = overlayTopRight(content, a.renderRunMetaBox(), a.width)
+	}
 
 	return lipgloss.JoinVertical(
 		lipgloss.Left,
@@ -1142,18 +2620,28 @@ func (a *App) renderWorkflowRunLogsView() string {
 
 func (a *App) renderWorkflowFileView() string {
 	title := "Workflow File"
-	if a.workflowFilePath != "" {
+	if a.workflowFileDiff != nil {
+		title = fmt.Sprintf("Workflow Diff: %s (%s)", a.workflowFilePath, a.workflowFileRef)
+		if !diffHasChanges(a.workflowFileDiff) {
+			title += " - no differences"
+		}
+	} else if a.workflowFilePath != "" {
 		title = fmt.Sprintf("Workflow File: %s", a.workflowFilePath)
 	}
 	header := a.styles.GetTitle().Render(title)
-	var body string
+	var body, tooltip string
 	if a.workflowFileLoading {
 		body = a.styles.GetStatusInProgress().Render("Loading workflow file...")
+	} else if a.workflowFileDiff != nil {
+		body = a.renderWorkflowDiff()
 	} else if a.workflowFileContent == "" {
 		body = a.styles.GetHelp().Render("(empty file)")
 	} else {
 		lines := strings.Split(a.workflowFileContent, "\n")
-		viewHeight := a.height - 4 // header + help
+		viewHeight := a.height - 4 - breadcrumbHeight // breadcrumb + header + help
+		if len(a.workflowFileErrors) > 0 {
+			viewHeight-- // エラーメッセージの行
+		}
 		if viewHeight < 1 {
 			viewHeight = 1
 		}
@@ -1168,14 +2656,38 @@ func (a *App) renderWorkflowFileView() string {
 		visibleRaw := lines[start:end]
 		digits := len(fmt.Sprintf("%d", len(lines)))
 		visible := make([]string, len(visibleRaw))
+		var visibleErr *workflowFileError
+		inBlockScalar := yamlBlockScalarLines(lines[:end])
 		for i, raw := range visibleRaw {
-			high := a.applyYAMLHighlight(raw)
+			high := a.applyYAMLHighlight(raw, inBlockScalar[start+i])
 			ln := start + i + 1
-			visible[i] = fmt.Sprintf("%*d | %s", digits, ln, high)
+			// パースエラーのある行は赤い!を表示する
+			gutter := " "
+			if fileErr := a.workflowFileErrorAt(ln); fileErr != nil {
+				gutter = a.styles.StatusFailure.Render("!")
+				if visibleErr == nil {
+					visibleErr = fileErr
+				}
+			}
+			visible[i] = fmt.Sprintf("%s%*d | %s", gutter, digits, ln, high)
 		}
 		body = lipgloss.NewStyle().Width(a.width - 4).Render(strings.Join(visible, "\n"))
+		if visibleErr != nil {
+			tooltip = a.styles.StatusFailure.Render(fmt.Sprintf("! line %d: %s", visibleErr.Line, visibleErr.Message))
+		} else if len(a.workflowFileErrors) > 0 {
+			// 行番号が分からないエラーや画面外のエラー
+			fileErr := a.workflowFileErrors[0]
+			message := fileErr.Message
+			if fileErr.Line > 0 {
+				message = fmt.Sprintf("line %d: %s", fileErr.Line, message)
+			}
+			tooltip = a.styles.StatusFailure.Render(fmt.Sprintf("! %d YAML error(s): %s", len(a.workflowFileErrors), message))
+		}
 	}
 	help := a.styles.GetHelp().Render("Esc|←: Close • ↑/↓ PgUp/PgDn g/G: Scroll • q: Quit")
+	if tooltip != "" {
+		return lipgloss.JoinVertical(lipgloss.Left, header, body, tooltip, help)
+	}
 	return lipgloss.JoinVertical(lipgloss.Left, header, body, help)
 }
 
@@ -1185,7 +2697,9 @@ type workflowsLoadedMsg struct {
 }
 
 type workflowRunsLoadedMsg struct {
-	runs []models.WorkflowRun
+	runs  []models.WorkflowRun
+	total int
+	page  int
 }
 
 type errorMsg struct {
@@ -1193,11 +2707,18 @@ type errorMsg struct {
 }
 
 type logsLoadedMsg struct {
-	logs string
+	runID int64
+	logs  *models.RunLogs
+	err   error
 }
 
 type jobsLoadedMsg struct {
-	jobs []models.Job
+	owner  string
+	repo   string
+	runID  int64
+	status string // 読み込み開始時のランの状態
+	jobs   []models.Job
+	cached bool
 }
 
 type allRunsLoadedMsg struct {
@@ -1216,12 +2737,86 @@ type allRunsPaginatedLoadedMsg struct {
 	page  int
 }
 
+type logsSavedMsg struct {
+	path string
+}
+
+type workflowScheduleLoadedMsg struct {
+	workflow models.Workflow
+	branch   string
+	content  string
+	schedule *components.WorkflowSchedule
+	err      error
+}
+
+type workflowStatsLoadedMsg struct {
+	workflowID int64
+	stats      *components.WorkflowStats
+	err        error
+}
+
+// workflowStatsDebounceMsg fires when scrolling the workflow list has settled
+type workflowStatsDebounceMsg struct {
+	seq int
+}
+
+type browserOpenedMsg struct {
+	url string
+	err error
+}
+
+type clearStatusMsg struct {
+	id int
+}
+
+type autoRefreshMsg struct{}
+
+// blinkMsg toggles the blinking marker of in-progress runs
+type blinkMsg struct{}
+
+type branchRunsPaginatedLoadedMsg struct {
+	runs  []models.WorkflowRun
+	total int
+	page  int
+}
+
 // workflow file load result
 type workflowFileLoadedMsg struct {
-	content string
-	path    string
-	ref     string
-	err     error
+	content       string
+	path          string
+	ref           string
+	defaultBranch string // set when ref was resolved to the default branch
+	err           error
+}
+
+// runWorkflowFile returns the path and ref of the workflow file of the current run.
+// The ref is empty when neither the head SHA nor the default branch is known.
+func (a *App) runWorkflowFile() (path, ref string) {
+	path = a.currentRun.Path
+	ref = a.currentRun.HeadSha
+	if path == "" && a.currentWorkflow != nil { // fallback
+		path = a.currentWorkflow.Path
+	}
+	if ref == "" {
+		// HeadShaが空ならデフォルトブランチのファイルを表示する
+		ref = a.defaultBranch
+	}
+	return path, ref
+}
+
+// fetchWorkflowFile fetches the workflow file at path and ref, resolving an empty ref to the default branch
+func (a *App) fetchWorkflowFile(path, ref string) workflowFileLoadedMsg {
+	msg := workflowFileLoadedMsg{path: path, ref: ref}
+	if ref == "" {
+		branch, err := a.client.GetDefaultBranch(a.owner, a.repo)
+		if err != nil {
+			msg.err = err
+			return msg
+		}
+		msg.ref, msg.defaultBranch = branch, branch
+	}
+	msg.content, msg.err = a.client.GetWorkflowFileAtRef(a.owner, a.repo, path, msg.ref)
+	return msg
 }
 
 // Commands
@@ -1237,7 +2832,7 @@ func (a *App) loadWorkflowsPaginated() tea.Cmd {
 
 func (a *App) loadAllRunsPaginated() tea.Cmd {
 	return tea.Cmd(func() tea.Msg {
-		allRuns, total, err := a.client.GetAllWorkflowRunsPaginated(a.owner, a.repo, a.allRunsPage, a.allRunsPerPage)
+		allRuns, total, err := a.client.GetAllWorkflowRunsPaginated(a.owner, a.repo, a.allRunsPage, a.allRunsPerPage, a.allRunsAPIFilter())
 		if err != nil {
 			return errorMsg{err: err}
 		}
@@ -1245,42 +2840,227 @@ func (a *App) loadAllRunsPaginated() tea.Cmd {
 	})
 }
 
+func (a *App) loadBranchRunsPaginated() tea.Cmd {
+	branch := a.branchFilter
+	page := a.branchRunsPage
+	return tea.Cmd(func() tea.Msg {
+		filter := a.runFilter()
+		filter.Branch = branch
+		runs, total, err := a.client.GetAllWorkflowRunsPaginated(a.owner, a.repo, page, a.allRunsPerPage, filter)
+		if err != nil {
+			return errorMsg{err: err}
+		}
+		return branchRunsPaginatedLoadedMsg{runs: runs, total: total, page: page}
+	})
+}
+
 func (a *App) loadWorkflowRuns(workflowID int64) tea.Cmd {
+	page := a.workflowRunsPage
 	return tea.Cmd(func() tea.Msg {
-		runs, err := a.client.GetWorkflowRuns(a.owner, a.repo, workflowID)
+		runs, total, err := a.client.GetWorkflowRunsPaginated(a.owner, a.repo, workflowID, page, a.workflowRunsPerPage, a.runFilter())
 		if err != nil {
 			return errorMsg{err: err}
 		}
-		return workflowRunsLoadedMsg{runs: runs}
+		return workflowRunsLoadedMsg{runs: runs, total: total, page: page}
 	})
 }
 
-func (a *App) loadWorkflowRunLogs(runID int64) tea.Cmd {
+func (a *App) loadWorkflowRunLogs(run models.WorkflowRun) tea.Cmd {
 	return tea.Cmd(func() tea.Msg {
 		// キャッシュヒット時は即返す
-		if cached, ok := a.logsCache[runID]; ok {
-			return logsLoadedMsg{logs: cached}
+		if cached, ok := a.logsCache.Get(run.ID); ok {
+			return logsLoadedMsg{runID: run.ID, logs: cached}
 		}
-		logs, err := a.client.GetWorkflowRunLogs(a.owner, a.repo, runID)
+		logs, err := a.client.GetWorkflowRunLogs(a.owner, a.repo, run.ID)
 		if err != nil {
-			return errorMsg{err: err}
+			return logsLoadedMsg{runID: run.ID, err: err}
+		}
+		// キャッシュ保存(実行中のランのログは変化するので完了済みのランのみ)
+		if run.Status == "completed" {
+			a.logsCache.Set(run.ID, logs)
+		}
+		return logsLoadedMsg{runID: run.ID, logs: logs}
+	})
+}
+
+// saveLogsToFile writes the current logs (without ANSI sequences) to path
+func (a *App) saveLogsToFile(path, content string) tea.Cmd {
+	return tea.Cmd(func() tea.Msg {
+		if err := os.WriteFile(path, []byte(logs.StripANSI(content)), 0o644); err != nil {
+			return errorMsg{err: fmt.Errorf("failed to save logs to %s: %w", path, err)}
+		}
+		return logsSavedMsg{path: path}
+	})
+}
+
+// loadSelectedWorkflowDetails loads the statistics and schedule shown in the preview of the selected workflow
+func (a *App) loadSelectedWorkflowDetails() tea.Cmd {
+	return tea.Batch(a.loadSelectedWorkflowStats(), a.loadSelectedWorkflowSchedule(), a.loadCacheUsage())
+}
+
+// loadSelectedWorkflowSchedule loads the schedule triggers from the workflow file on the default branch
+// of the selected workflow unless already cached
+func (a *App) loadSelectedWorkflowSchedule() tea.Cmd {
+	item, ok := a.workflowList.SelectedItem().(components.WorkflowItem)
+	if !ok || item.Workflow.Path == "" {
+		return nil
+	}
+	workflow := item.Workflow
+	if _, cached := a.workflowScheduleCache[workflow.ID]; cached {
+		return nil
+	}
+
+	// 取得中もエントリを作って重複リクエストを防ぐ
+	a.workflowScheduleCache[workflow.ID] = nil
+	branch := a.defaultBranch
+	cachedContent, hasContent := a.workflowFileCache[workflow.Path+"@"+branch]
+	return tea.Cmd(func() tea.Msg {
+		msg := workflowScheduleLoadedMsg{workflow: workflow, branch: branch, content: cachedContent}
+		if branch == "" {
+			defaultBranch, err := a.client.GetDefaultBranch(a.owner, a.repo)
+			if err != nil {
+				msg.err = err
+				return msg
+			}
+			msg.branch = defaultBranch
+		}
+		if !hasContent || branch == "" {
+			content, err := a.client.GetWorkflowFileAtRef(a.owner, a.repo, workflow.Path, msg.branch)
+			if err != nil {
+				msg.err = err
+				return msg
+			}
+			msg.content = content
 		}
-		// キャッシュ保存
-		a.logsCache[runID] = logs
-		return logsLoadedMsg{logs: logs}
+		msg.schedule, msg.err = components.NewWorkflowSchedule(msg.content, time.Now())
+		return msg
 	})
 }
 
-// scheduleJobsLoad schedules a debounced jobs load
-func (a *App) scheduleJobsLoad(runID int64) {
+// loadSelectedWorkflowStats loads recent run statistics of the selected workflow unless already cached
+func (a *App) loadSelectedWorkflowStats() tea.Cmd {
+	item, ok := a.workflowList.SelectedItem().(components.WorkflowItem)
+	if !ok {
+		return nil
+	}
+	return a.loadWorkflowStats(item.Workflow.ID)
+}
+
+// scheduleWorkflowStatsLoad schedules a debounced stats load of the workflows visible in the list
+func (a *App) scheduleWorkflowStatsLoad() tea.Cmd {
+	a.workflowStatsLoadSeq++
+	seq := a.workflowStatsLoadSeq
+	return tea.Tick(400*time.Millisecond, func(time.Time) tea.Msg {
+		return workflowStatsDebounceMsg{seq: seq}
+	})
+}
+
+// loadVisibleWorkflowStats loads the stats of the workflows on the current list page that are not cached yet
+func (a *App) loadVisibleWorkflowStats() tea.Cmd {
+	items := a.workflowList.VisibleItems()
+	start, end := a.workflowList.Paginator.GetSliceBounds(len(items))
+	var cmds []tea.Cmd
+	for _, listItem := range items[start:end] {
+		if item, ok := listItem.(components.WorkflowItem); ok {
+			cmds = append(cmds, a.loadWorkflowStats(item.Workflow.ID))
+		}
+	}
+	return tea.Batch(cmds...)
+}
+
+// loadWorkflowStats loads recent run statistics of a workflow unless already cached
+func (a *App) loadWorkflowStats(workflowID int64) tea.Cmd {
+	if _, cached := a.workflowStatsCache[workflowID]; cached {
+		return nil
+	}
+
+	// 取得中もエントリを作って重複リクエストを防ぐ
+	a.workflowStatsCache[workflowID] = nil
+	perPage := a.workflowsPerPage
+	return tea.Cmd(func() tea.Msg {
+		runs, _, err := a.client.GetWorkflowRunsPaginated(a.owner, a.repo, workflowID, 1, perPage, github.RunFilter{})
+		if err != nil {
+			return workflowStatsLoadedMsg{workflowID: workflowID, err: err}
+		}
+		return workflowStatsLoadedMsg{workflowID: workflowID, stats: components.NewWorkflowStats(runs)}
+	})
+}
+
+// logViewTransition logs the change of the view state made while handling a message
+func (a *App) logViewTransition(from ViewState) {
+	if a.viewState != from {
+		a.logger.Info("view transition", slog.String("from", from.String()), slog.String("to", a.viewState.String()))
+	}
+}
+
+// hasPreviewPanel reports whether the current view shows the preview panel next to the list
+func (a *App) hasPreviewPanel() bool {
+	switch a.viewState {
+	case AllRunsView, WorkflowListView, WorkflowRunsView, BranchRunsView, PRRunsView:
+		return true
+	}
+	return false
+}
+
+// togglePreview shows or hides the preview panel, giving the list the full width while hidden
+func (a *App) togglePreview() tea.Cmd {
+	a.previewVisible = !a.previewVisible
+	// SetSizeはカーソル位置を保持する
+	a.updateListSizes()
+	if a.previewVisible {
+		return a.flashStatus("Preview: shown")
+	}
+	return a.flashStatus("Preview: hidden")
+}
+
+// cycleListDensity switches run lists to the next density and saves it to the config file
+func (a *App) cycleListDensity() tea.Cmd {
+	a.listDensity = (a.listDensity + 1) % len(config.ListDensities)
+	a.runDelegate.SetDensity(a.listDensity)
+	a.updateListSizes()
+
+	density := config.ListDensities[a.listDensity]
+	return tea.Batch(
+		a.flashStatus("Density: "+density),
+		func() tea.Msg {
+			if err := config.SaveDefaultListDensity(density); err != nil {
+				return errorMsg{err: err}
+			}
+			return nil
+		},
+	)
+}
+
+// openInBrowser opens url in the default browser
+func (a *App) openInBrowser(url string) tea.Cmd {
+	return tea.Cmd(func() tea.Msg {
+		return browserOpenedMsg{url: url, err: browser.Open(url)}
+	})
+}
+
+// flashStatus shows a status message that is cleared after one second
+func (a *App) flashStatus(message string) tea.Cmd {
+	a.statusMessageID++
+	a.statusMessage = message
+	id := a.statusMessageID
+	return tea.Tick(time.Second, func(time.Time) tea.Msg {
+		return clearStatusMsg{id: id}
+	})
+}
+
+// scheduleJobsLoad schedules a debounced jobs load and returns the command animating the preview spinner
+func (a *App) scheduleJobsLoad(runID int64) tea.Cmd {
 	a.debounceMutex.Lock()
 	defer a.debounceMutex.Unlock()
 
 	// キャッシュから取得を試行
 	if jobs, found := a.jobsCache.Get(runID); found {
 		a.currentJobs = jobs
-		return
+		a.jobsLoading = false
+		a.previewPanel.SetJobsSpinner("")
+		return a.loadRunUsage(runID)
 	}
+	a.jobsLoading = true
 
 	// Cancel existing timer
 	if a.debounceTimer != nil {
@@ -1288,61 +3068,301 @@ func (a *App) scheduleJobsLoad(runID int64) {
 	}
 
 	a.pendingRunID = runID
+	a.pendingRepo = a.owner + "/" + a.repo
+	owner, repo, status := a.owner, a.repo, a.runStatus(runID)
 
 	// Set new timer
 	a.debounceTimer = time.AfterFunc(400*time.Millisecond, func() {
 		// Execute the API call after debounce period
-		a.executeJobsLoad(runID)
+		a.executeJobsLoad(owner, repo, runID, status)
+	})
+	usageDebounce := tea.Tick(400*time.Millisecond, func(time.Time) tea.Msg {
+		return runUsageDebounceMsg{runID: runID}
 	})
+	spinnerCmd := a.startJobsSpinner()
+	a.previewPanel.SetJobsSpinner(a.jobsLoadingSpinner.View())
+	return tea.Batch(spinnerCmd, usageDebounce)
 }
 
 // executeJobsLoad executes the actual jobs load
-func (a *App) executeJobsLoad(runID int64) {
+func (a *App) executeJobsLoad(owner, repo string, runID int64, status string) {
 	a.debounceMutex.Lock()
 	defer a.debounceMutex.Unlock()
 
 	// Check if this is still the pending request
-	if a.pendingRunID != runID {
+	if !a.isPendingJobsLoad(owner, repo, runID) {
 		return
 	}
 
 	// キャッシュから再度確認（並行処理対策）
 	if jobs, found := a.jobsCache.Get(runID); found {
 		a.currentJobs = jobs
+		a.jobsLoading = false
 		return
 	}
 
 	// API呼び出し実行
 	go func() {
-		jobs, err := a.client.GetWorkflowRunJobs(a.owner, a.repo, runID)
+		jobs, err := a.client.GetWorkflowRunJobs(owner, repo, runID, github.JobsFilterLatest)
+		if err == nil {
+			a.fetchPendingDeployments(owner, repo, runID, jobs)
+		}
+
+		a.debounceMutex.Lock()
+		defer a.debounceMutex.Unlock()
+		// 後から別のランやリポジトリが選択されていれば結果を破棄し、読み込み中のままにする
+		if !a.isPendingJobsLoad(owner, repo, runID) {
+			return
+		}
 		if err == nil {
 			// キャッシュに保存
-			a.jobsCache.Set(runID, jobs)
+			a.jobsCache.Set(runID, jobs, jobsCacheTTL(status, jobs))
 			a.currentJobs = jobs
 		}
+		a.jobsLoading = false
 	}()
 }
 
+// isPendingJobsLoad reports whether the debounced jobs load of a run is still wanted.
+// The caller must hold debounceMutex.
+func (a *App) isPendingJobsLoad(owner, repo string, runID int64) bool {
+	return a.pendingRunID == runID && a.pendingRepo == owner+"/"+repo
+}
+
+// cancelJobsLoad drops the pending debounced jobs load
+func (a *App) cancelJobsLoad() {
+	a.debounceMutex.Lock()
+	defer a.debounceMutex.Unlock()
+
+	if a.debounceTimer != nil {
+		a.debounceTimer.Stop()
+	}
+	a.pendingRunID = 0
+	a.pendingRepo = ""
+	a.jobsLoading = false
+	a.previewPanel.SetJobsSpinner("")
+}
+
+// jobsSpinnerMsg advances the spinner shown in the preview while jobs are loading
+type jobsSpinnerMsg struct{}
+
+// setJobsLoading sets whether the preview waits for the jobs of the selected run
+func (a *App) setJobsLoading(loading bool) {
+	a.debounceMutex.Lock()
+	defer a.debounceMutex.Unlock()
+	a.jobsLoading = loading
+}
+
+// isJobsLoading reports whether the preview waits for the jobs of the selected run
+func (a *App) isJobsLoading() bool {
+	a.debounceMutex.Lock()
+	defer a.debounceMutex.Unlock()
+	return a.jobsLoading
+}
+
+// startJobsSpinner starts animating the jobs spinner unless it is already running
+func (a *App) startJobsSpinner() tea.Cmd {
+	if a.jobsSpinnerRunning {
+		return nil
+	}
+	a.jobsSpinnerRunning = true
+	a.jobsLoadingSpinner.Reset()
+	return tea.Tick(paginationSpinnerInterval, func(time.Time) tea.Msg {
+		return jobsSpinnerMsg{}
+	})
+}
+
+// handleJobsSpinner advances the jobs spinner until the jobs are loaded
+func (a *App) handleJobsSpinner() (tea.Model, tea.Cmd) {
+	if !a.isJobsLoading() {
+		// 読み込み完了後のこのTickで再描画される
+		a.jobsSpinnerRunning = false
+		a.syncJobsSpinner()
+		return a, nil
+	}
+	a.jobsLoadingSpinner.Tick()
+	a.syncJobsSpinner()
+	return a, tea.Tick(paginationSpinnerInterval, func(time.Time) tea.Msg {
+		return jobsSpinnerMsg{}
+	})
+}
+
+// syncJobsSpinner shows the current spinner frame in the run preview, or the jobs when they are not loading
+func (a *App) syncJobsSpinner() {
+	if !a.isJobsLoading() {
+		a.previewPanel.SetJobsSpinner("")
+		return
+	}
+	a.previewPanel.SetJobsSpinner(a.jobsLoadingSpinner.View())
+}
+
 func (a *App) loadWorkflowRunJobs(runID int64) tea.Cmd {
-	return tea.Cmd(func() tea.Msg {
+	owner, repo, status := a.owner, a.repo, a.runStatus(runID)
+	return tea.Batch(a.loadRunUsage(runID), func() tea.Msg {
 		// キャッシュから取得を試行
 		if jobs, found := a.jobsCache.Get(runID); found {
-			return jobsLoadedMsg{jobs: jobs}
+			return jobsLoadedMsg{owner: owner, repo: repo, runID: runID, status: status, jobs: jobs, cached: true}
 		}
 
-		// キャッシュにない場合のみAPI呼び出し
-		jobs, err := a.client.GetWorkflowRunJobs(a.owner, a.repo, runID)
+		// キャッシュにない場合のみAPI呼び出し(キャッシュへの保存はUpdateで行う)
+		jobs, err := a.client.GetWorkflowRunJobs(owner, repo, runID, github.JobsFilterLatest)
 		if err != nil {
 			return errorMsg{err: err}
 		}
 
-		// キャッシュに保存
-		a.jobsCache.Set(runID, jobs)
+		a.fetchPendingDeployments(owner, repo, runID, jobs)
 
-		return jobsLoadedMsg{jobs: jobs}
+		return jobsLoadedMsg{owner: owner, repo: repo, runID: runID, status: status, jobs: jobs}
 	})
 }
 
+// runStatus returns the status of a run in the loaded run lists, or "" when it is not listed.
+// It reads the lists and must be called from Update, not from a command.
+func (a *App) runStatus(runID int64) string {
+	for _, runs := range [][]models.WorkflowRun{a.allRuns, a.workflowRuns, a.branchRuns, a.prRuns} {
+		for _, run := range runs {
+			if run.ID == runID {
+				return run.Status
+			}
+		}
+	}
+	return ""
+}
+
+// jobsCacheTTL returns how long the jobs of a run are cached: long for completed runs
+// whose jobs never change, short for runs that are still running.
+// An empty status (the run is not listed) is decided from the jobs.
+func jobsCacheTTL(status string, jobs []models.Job) time.Duration {
+	if status == "completed" {
+		return completedJobsCacheTTL
+	}
+	if status != "" {
+		return activeJobsCacheTTL
+	}
+
+	// 一覧にないランはジョブの状態から判断する
+	for _, job := range jobs {
+		if job.Status != "completed" {
+			return activeJobsCacheTTL
+		}
+	}
+	return completedJobsCacheTTL
+}
+
+// fetchPendingDeployments fetches and stores the pending deployments of a run
+// when any of its jobs is waiting for approval
+func (a *App) fetchPendingDeployments(owner, repo string, runID int64, jobs []models.Job) {
+	waiting := false
+	for _, job := range jobs {
+		if job.Status == "waiting" {
+			waiting = true
+			break
+		}
+	}
+
+	var deployments []models.PendingDeployment
+	if waiting {
+		var err error
+		deployments, err = a.client.GetPendingDeployments(owner, repo, runID)
+		if err != nil {
+			// 承認待ちの表示は補助情報なので失敗しても無視する
+			return
+		}
+	}
+
+	a.pendingDeploymentsMutex.Lock()
+	defer a.pendingDeploymentsMutex.Unlock()
+	if len(deployments) == 0 {
+		delete(a.pendingDeployments, runID)
+		return
+	}
+	a.pendingDeployments[runID] = deployments
+}
+
+// withPendingDeployments returns a copy of run with its stored pending deployments
+func (a *App) withPendingDeployments(run *models.WorkflowRun) *models.WorkflowRun {
+	if run == nil {
+		return nil
+	}
+
+	a.pendingDeploymentsMutex.RLock()
+	defer a.pendingDeploymentsMutex.RUnlock()
+	deployments, ok := a.pendingDeployments[run.ID]
+	if !ok {
+		return run
+	}
+	withDeployments := *run
+	withDeployments.PendingDeployments = deployments
+	return &withDeployments
+}
+
+// runUsageDebounceMsg requests the billable time of a run once the selection has settled
+type runUsageDebounceMsg struct {
+	runID int64
+}
+
+// runUsageLoadedMsg carries the billable time of a run
+type runUsageLoadedMsg struct {
+	owner  string
+	repo   string
+	runID  int64
+	status string // 読み込み開始時のランの状態
+	usage  *models.WorkflowRunUsage
+	err    error
+}
+
+// loadRunUsage fetches the billable time of a run unless it is cached
+func (a *App) loadRunUsage(runID int64) tea.Cmd {
+	if _, found := a.billingCache.Get(runID); found {
+		return nil
+	}
+	owner, repo, status := a.owner, a.repo, a.runStatus(runID)
+	return func() tea.Msg {
+		usage, err := a.client.GetWorkflowRunUsage(owner, repo, runID)
+		return runUsageLoadedMsg{owner: owner, repo: repo, runID: runID, status: status, usage: usage, err: err}
+	}
+}
+
+// handleRunUsageLoaded caches the billable time of a run
+func (a *App) handleRunUsageLoaded(msg runUsageLoadedMsg) (tea.Model, tea.Cmd) {
+	// 読み込み中にリポジトリを切り替えていたら破棄する
+	if msg.owner != a.owner || msg.repo != a.repo {
+		return a, nil
+	}
+
+	// 実行中のランの課金時間は増えていくので短い時間だけキャッシュする
+	ttl := completedJobsCacheTTL
+	if msg.status != "completed" {
+		ttl = activeJobsCacheTTL
+	}
+	if msg.err != nil {
+		// 課金情報が取得できないリポジトリでは何も表示しない(空をキャッシュして再取得を防ぐ)
+		// 一時的なエラーはキャッシュせず次の選択時に再取得する
+		var ghErr *github.GitHubError
+		if !errors.As(msg.err, &ghErr) || (ghErr.Type != github.ErrorTypeNotFound && ghErr.Type != github.ErrorTypePermission) {
+			return a, nil
+		}
+		msg.usage = nil
+	}
+	a.billingCache.SetWithTTL(msg.runID, msg.usage, ttl)
+	return a, nil
+}
+
+// withRunUsage returns a copy of run with its cached billable time attached
+func (a *App) withRunUsage(run *models.WorkflowRun) *models.WorkflowRun {
+	if run == nil {
+		return nil
+	}
+
+	usage, found := a.billingCache.Get(run.ID)
+	if !found || usage == nil {
+		return run
+	}
+	withUsage := *run
+	withUsage.Usage = usage
+	return &withUsage
+}
+
 // handleLogNavigation handles navigation in the logs view
 func (a *App) handleLogNavigation(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	if a.logs == "" {
@@ -1350,40 +3370,302 @@ func (a *App) handleLogNavigation(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	}
 
 	lines := strings.Split(a.logs, "\n")
-	viewHeight := a.height - 6
-	maxOffset := len(lines) - viewHeight
+	viewHeight := a.height - 6 - breadcrumbHeight
+	maxOffset := a.logDisplayCount(len(lines)) - viewHeight
 	if maxOffset < 0 {
 		maxOffset = 0
 	}
 
 	switch {
-	case key.Matches(msg, a.keyMap.Up):
-		if a.logOffset > 0 {
-			a.logOffset--
+	case key.Matches(msg, a.keyMap.Enter):
+		// アノテーションのある行ではメッセージ全文を表示する
+		if annotation, ok := a.annotationAtCursor(viewHeight); ok {
+			a.annotationDetail = &annotation
+			return a, nil
 		}
-	case key.Matches(msg, a.keyMap.Down):
-		if a.logOffset < maxOffset {
-			a.logOffset++
+		// Enterで先頭行のステップセクションを開閉する
+		a.toggleStepSectionAtTop()
+		if a.logOffset > maxOffset {
+			a.logOffset = max(a.logDisplayCount(len(lines))-viewHeight, 0)
 		}
+	case key.Matches(msg, a.keyMap.Up, a.keyMap.PageUp, a.keyMap.Home):
+		// 上方向に移動したら末尾への追従をやめる
+		a.logOffset, _ = a.scrollOffset(msg, a.logOffset, viewHeight, maxOffset)
+		a.logTail = false
+	default:
+		a.logOffset, _ = a.scrollOffset(msg, a.logOffset, viewHeight, maxOffset)
+	}
+
+	return a, nil
+}
+
+// scrollOffset applies the scroll keys (up/down, page up/down, home/end) to the offset of a scrollable view.
+// It reports false when msg is not a scroll key.
+func (a *App) scrollOffset(msg tea.KeyMsg, offset, viewHeight, maxOffset int) (int, bool) {
+	switch {
+	case key.Matches(msg, a.keyMap.Up):
+		return max(offset-1, 0), true
+	case key.Matches(msg, a.keyMap.Down):
+		return min(offset+1, maxOffset), true
 	case key.Matches(msg, a.keyMap.PageUp):
-		a.logOffset -= viewHeight
-		if a.logOffset < 0 {
-			a.logOffset = 0
-		}
+		return max(offset-viewHeight, 0), true
 	case key.Matches(msg, a.keyMap.PageDown):
-		a.logOffset += viewHeight
-		if a.logOffset > maxOffset {
-			a.logOffset = maxOffset
-		}
+		return min(offset+viewHeight, maxOffset), true
 	case key.Matches(msg, a.keyMap.Home):
-		a.logOffset = 0
+		return 0, true
 	case key.Matches(msg, a.keyMap.End):
-		a.logOffset = maxOffset
+		return maxOffset, true
 	}
+	return offset, false
+}
+
+// openJobSidebar opens the job selector sidebar for the current run
+func (a *App) openJobSidebar() (tea.Model, tea.Cmd) {
+	a.showJobSidebar = true
+	a.jobSidebarIndex = 0
+	a.expandedJobGroups = make(map[string]bool)
 
+	// プレビュー用にキャッシュ済みのジョブを再利用する
+	if jobs, found := a.jobsCache.Get(a.currentRun.ID); found {
+		a.currentJobs = jobs
+		return a, nil
+	}
+	if len(a.currentJobs) > 0 && a.currentJobs[0].RunID == a.currentRun.ID {
+		return a, nil
+	}
+	a.currentJobs = nil
+	return a, a.loadWorkflowRunJobs(a.currentRun.ID)
+}
+
+// handleJobSidebarKey handles keyboard input while the job sidebar is open
+func (a *App) handleJobSidebarKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	groups, rows := a.jobSidebarRows()
+	switch {
+	case msg.String() == "J" || key.Matches(msg, a.keyMap.Back):
+		a.showJobSidebar = false
+	case key.Matches(msg, a.keyMap.Up):
+		if a.jobSidebarIndex > 0 {
+			a.jobSidebarIndex--
+		}
+	case key.Matches(msg, a.keyMap.Down):
+		if a.jobSidebarIndex < len(rows)-1 {
+			a.jobSidebarIndex++
+		}
+	case msg.String() == "l":
+		// 選択したジョブのログだけを取得して表示する
+		if a.jobSidebarIndex < len(rows) && rows[a.jobSidebarIndex].job >= 0 {
+			a.showJobSidebar = false
+			return a, tea.Batch(a.loadJobLogs(a.currentJobs[rows[a.jobSidebarIndex].job]), a.flashStatus("Loading job log..."))
+		}
+	case key.Matches(msg, a.keyMap.Enter):
+		if a.jobSidebarIndex >= len(rows) {
+			a.showJobSidebar = false
+			break
+		}
+		row := rows[a.jobSidebarIndex]
+		// マトリックスのグループ行は展開/折りたたみ
+		if row.job < 0 {
+			name := groups[row.group].Name
+			a.expandedJobGroups[name] = !a.expandedJobGroups[name]
+			return a, nil
+		}
+		// ジョブ単位のログ表示中は選択したジョブのログに切り替える
+		if a.jobLog != nil {
+			a.showJobSidebar = false
+			return a, a.loadJobLogs(a.currentJobs[row.job])
+		}
+		if line := findJobLogLine(a.logs, a.currentJobs[row.job].Name); line >= 0 {
+			a.jumpToLogLine(line)
+		}
+		a.showJobSidebar = false
+	}
 	return a, nil
 }
 
+// jobSidebarRow is a row of the job sidebar: a job, or the header of a matrix group
+type jobSidebarRow struct {
+	group int
+	job   int // index into currentJobs, -1 for a matrix group header
+}
+
+// jobSidebarRows groups the current jobs by matrix and returns the rows shown in the sidebar
+func (a *App) jobSidebarRows() ([]components.JobGroup, []jobSidebarRow) {
+	groups := components.GroupMatrixJobs(a.currentJobs)
+	var rows []jobSidebarRow
+	for gi, group := range groups {
+		if !group.IsMatrix() {
+			rows = append(rows, jobSidebarRow{group: gi, job: group.Indexes[0]})
+			continue
+		}
+		rows = append(rows, jobSidebarRow{group: gi, job: -1})
+		if a.expandedJobGroups[group.Name] {
+			for _, i := range group.Indexes {
+				rows = append(rows, jobSidebarRow{group: gi, job: i})
+			}
+		}
+	}
+	return groups, rows
+}
+
+// findJobLogLine returns the first line index of a job header in the combined log, or -1
+func findJobLogLine(content, jobName string) int {
+	if jobName == "" {
+		return -1
+	}
+	for i, line := range strings.Split(content, "\n") {
+		trimmed := strings.TrimSpace(line)
+		isHeader := strings.HasPrefix(trimmed, "=== ") || strings.Contains(trimmed, "##[group]")
+		if isHeader && strings.Contains(trimmed, jobName) {
+			return i
+		}
+	}
+	return -1
+}
+
+// jumpToLogLine scrolls the log view so that the given line index is at the top,
+// expanding the step section that contains it
+func (a *App) jumpToLogLine(line int) {
+	lines := strings.Split(a.logs, "\n")
+	if line >= len(lines) {
+		line = len(lines) - 1
+	}
+	if line < 0 {
+		line = 0
+	}
+	a.expandStepSectionAt(line)
+	a.logCursorLine = line
+
+	row := a.logRowOfLine(line)
+	maxOffset := a.logDisplayCount(len(lines)) - (a.height - 6 - breadcrumbHeight)
+	if maxOffset < 0 {
+		maxOffset = 0
+	}
+	if row > maxOffset {
+		row = maxOffset
+	}
+	a.logOffset = row
+}
+
+// renderJobSidebar renders the job selector sidebar
+func (a *App) renderJobSidebar(height int) string {
+	var content strings.Builder
+	content.WriteString(a.styles.GetTitle().Render("Jobs"))
+	content.WriteString("\n\n")
+
+	if len(a.currentJobs) == 0 {
+		content.WriteString(a.styles.GetStatusInProgress().Render("Loading jobs..."))
+	}
+
+	nameWidth := a.styles.Sidebar.GetWidth() - 8
+	groups, rows := a.jobSidebarRows()
+	for i, row := range rows {
+		group := groups[row.group]
+		var jobStatus, name, indent string
+		switch {
+		case row.job < 0:
+			// 折りたたみ中は全マトリックスの中で最も悪い結果を表示する
+			jobStatus = components.WorstJobStatus(a.currentJobs, group)
+			marker := "▸"
+			if a.expandedJobGroups[group.Name] {
+				marker = "▾"
+			}
+			name = fmt.Sprintf("%s %s (%d)", marker, group.Name, len(group.Indexes))
+		case group.IsMatrix():
+			job := a.currentJobs[row.job]
+			jobStatus = components.GetCIStatus(job.Status, job.Conclusion)
+			indent = "  "
+			for k, idx := range group.Indexes {
+				if idx == row.job {
+					name = group.Params[k]
+				}
+			}
+		default:
+			job := a.currentJobs[row.job]
+			jobStatus = components.GetCIStatus(job.Status, job.Conclusion)
+			name = job.Name
+		}
+		// マルチバイト文字の途中で切らないようにルーン単位で切り詰める
+		if width, runes := nameWidth-len(indent), []rune(name); len(runes) > width && width > 3 {
+			name = string(runes[:width-3]) + "..."
+		}
+		line := fmt.Sprintf("%s%s %s", indent, a.styles.StatusStyle(jobStatus).Render(components.StatusIcon(jobStatus)), name)
+		if i == a.jobSidebarIndex {
+			line = a.styles.SelectedItem().Render(line)
+		} else {
+			line = a.styles.ListItem().Render(line)
+		}
+		content.WriteString(line)
+		content.WriteString("\n")
+	}
+
+	sidebarHeight := height - 2
+	if sidebarHeight < 1 {
+		sidebarHeight = 1
+	}
+	return a.styles.Sidebar.Height(sidebarHeight).Render(content.String())
+}
+
+// jobColors is the palette used to color-code log lines by job
+var jobColors = []lipgloss.Color{"39", "208", "135", "42", "203", "220", "45", "170"}
+
+// findLogSection returns the log section containing the given line index, or nil
+func findLogSection(sections []models.LogSection, line int) *models.LogSection {
+	i := sort.Search(len(sections), func(i int) bool {
+		return sections[i].EndLine > line
+	})
+	if i < len(sections) && sections[i].StartLine <= line {
+		return &sections[i]
+	}
+	return nil
+}
+
+// jobIndexForLogFile returns the index of the job a log file belongs to, or -1.
+// Log files are named "<job>/<n>_<step>.txt" or "<n>_<job>.txt".
+func jobIndexForLogFile(fileName string, jobs []models.Job) int {
+	base := fileName
+	if slash := strings.Index(base, "/"); slash >= 0 {
+		base = base[:slash]
+	} else {
+		base = strings.TrimSuffix(base, ".txt")
+		if underscore := strings.Index(base, "_"); underscore >= 0 {
+			if _, err := strconv.Atoi(base[:underscore]); err == nil {
+				base = base[underscore+1:]
+			}
+		}
+	}
+	if base == "" {
+		return -1
+	}
+
+	for i, job := range jobs {
+		if job.Name == base {
+			return i
+		}
+	}
+	// GitHub truncates and sanitizes file names, so fall back to prefix matching
+	for i, job := range jobs {
+		if strings.HasPrefix(job.Name, base) || strings.HasPrefix(base, job.Name) {
+			return i
+		}
+	}
+	return -1
+}
+
+// workflowCommandRegex extracts the command of a "::notice::" style workflow command,
+// optionally with parameters (e.g. "::error file=app.js,line=1::message").
+// The command must start the line, after the optional timestamp, so that text such as
+// "module::error::AppError" is not mistaken for a command.
+var workflowCommandRegex = regexp.MustCompile(`^(?:\d{4}-\d{2}-\d{2}T\S+\s+)?::(notice|error|warning|debug)(?: [^\n]*?)?::`)
+
+// workflowCommand returns the workflow command of a log line ("notice", "error", "warning" or "debug"),
+// or "" when the line is not a workflow command
+func workflowCommand(line string) string {
+	if m := workflowCommandRegex.FindStringSubmatch(strings.TrimSpace(line)); m != nil {
+		return m[1]
+	}
+	return ""
+}
+
 // applySimpleHighlight applies simple color highlighting to log lines without borders
 func (a *App) applySimpleHighlight(line string) string {
 	// Only apply color changes, no borders or complex styling
@@ -1409,6 +3691,20 @@ func (a *App) applySimpleHighlight(line string) string {
 		return lipgloss.NewStyle().Foreground(lipgloss.Color("226")).Bold(true).Render(line) // Bold yellow
 	}
 
+	// Workflow commands (::notice::, ::error::, ::warning::, ::debug::)
+	if command := workflowCommand(trimmedLine); command != "" {
+		switch command {
+		case "error":
+			return lipgloss.NewStyle().Foreground(lipgloss.Color("196")).Bold(true).Render(line) // Bold red
+		case "warning":
+			return lipgloss.NewStyle().Foreground(lipgloss.Color("226")).Bold(true).Render(line) // Bold yellow
+		case "notice":
+			return lipgloss.NewStyle().Foreground(lipgloss.Color("51")).Render(line) // Cyan
+		case "debug":
+			return lipgloss.NewStyle().Foreground(lipgloss.Color("240")).Render(line) // Grey
+		}
+	}
+
 	// Return line as-is if no pattern matches
 	return line
 }
@@ -1486,8 +3782,35 @@ func (a *App) renderError(err error) string {
 	return a.styles.StatusFailure.Render(fmt.Sprintf("❌ エラー: %s", err.Error()))
 }
 
+// yamlBlockScalarStart matches a line opening a literal (|) or folded (>) block scalar,
+// e.g. "run: |", "- script: >-" or "key: |2 # comment"
+var yamlBlockScalarStart = regexp.MustCompile(`^[ \t]*(?:-[ \t]+)*(?:[A-Za-z0-9_."'\-]+:[ \t]*)?[|>][-+0-9]*[ \t]*(?:#.*)?$`)
+
+// yamlBlockScalarLines reports for each line whether it is the content of a block scalar.
+// A block scalar continues over blank lines and lines indented deeper than the line that opened it.
+func yamlBlockScalarLines(lines []string) []bool {
+	inBlock := make([]bool, len(lines))
+	blockIndent := -1 // indentation of the line that opened the current block scalar
+	for i, line := range lines {
+		line = strings.TrimRight(line, "\r")
+		indent := len(line) - len(strings.TrimLeft(line, " \t"))
+		if blockIndent >= 0 {
+			if strings.TrimSpace(line) == "" || indent > blockIndent {
+				inBlock[i] = true
+				continue
+			}
+			// 同じかそれより浅いインデントの行でブロックが終わる
+			blockIndent = -1
+		}
+		if yamlBlockScalarStart.MatchString(line) {
+			blockIndent = indent
+		}
+	}
+	return inBlock
+}
+
 // applyYAMLHighlight provides simple inline YAML syntax highlighting.
-func (a *App) applyYAMLHighlight(line string) string {
+func (a *App) applyYAMLHighlight(line string, inBlockScalar bool) string {
 	trimmed := strings.TrimRight(line, "\r")
 	if strings.TrimSpace(trimmed) == "" {
 		return trimmed
@@ -1508,6 +3831,11 @@ func (a *App) applyYAMLHighlight(line string) string {
 	numStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("148"))
 	commentStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("59")).Italic(true)
 
+	// ブロックスカラー(run: | など)の中身はキーやコメントに見えても文字列として表示する
+	if inBlockScalar {
+		return strStyle.Render(trimmed)
+	}
+
 	// Key (supports leading spaces and list dash)
 	keyRegex := regexp.MustCompile(`^([ \t-]*)([A-Za-z0-9_."'\-]+):(.*)$`)
 	if m := keyRegex.FindStringSubmatch(codePart); m != nil {
@@ -1539,10 +3867,107 @@ func (a *App) applyYAMLHighlight(line string) string {
 	return codePart
 }
 
+// startSearchInput starts the search input of the log view (also used by the debug log view)
+func (a *App) startSearchInput() {
+	a.searchInputMode = true
+	a.searchInputBuffer = ""
+	a.searchHistoryIndex = -1
+}
+
+// currentSearchQuery returns the query to highlight: the input while typing, otherwise the confirmed query
+func (a *App) currentSearchQuery() string {
+	if a.searchInputMode && a.searchInputBuffer != "" {
+		return a.searchInputBuffer
+	}
+	return a.searchActiveQuery
+}
+
+// searchTargetLines returns the lines searched by the search input of the current view
+func (a *App) searchTargetLines() []string {
+	if a.viewState == DebugLogView {
+		return a.debugLogLines()
+	}
+	return strings.Split(a.logs, "\n")
+}
+
+// matchingLines returns the indexes of the lines matching matchLine
+func matchingLines(lines []string, matchLine func(string) []int) []int {
+	var matches []int
+	for i, line := range lines {
+		// ログに含まれる色のエスケープシーケンスには一致させない
+		if matchLine(logs.StripANSI(line)) != nil {
+			matches = append(matches, i)
+		}
+	}
+	return matches
+}
+
+// highlightSearchMatch highlights the first match of matchLine in line.
+// The line is returned unchanged when it does not match.
+func highlightSearchMatch(line string, matchLine func(string) []int) string {
+	// 色付け済みの行ではエスケープシーケンスに一致してしまうので、素のテキストで検索してハイライトする
+	plain := logs.StripANSI(line)
+	loc := matchLine(plain)
+	if loc == nil {
+		return line
+	}
+	match := lipgloss.NewStyle().Foreground(lipgloss.Color("226")).Bold(true).Render(plain[loc[0]:loc[1]])
+	return plain[:loc[0]] + match + plain[loc[1]:]
+}
+
+// jumpToSearchMatch scrolls the current view to the matched line
+func (a *App) jumpToSearchMatch(line int) {
+	if a.viewState == DebugLogView {
+		a.debugOffset = min(line, max(len(a.debugLogLines())-a.debugLogViewHeight(), 0))
+		return
+	}
+	a.jumpToLogLine(line)
+}
+
+// jumpToNextSearchMatch moves to the next (or previous) search match, wrapping around at the ends
+func (a *App) jumpToNextSearchMatch(forward bool) {
+	if a.searchActiveQuery == "" || len(a.searchMatchIndices) == 0 {
+		return
+	}
+	if forward {
+		a.searchMatchIndex = (a.searchMatchIndex + 1) % len(a.searchMatchIndices)
+	} else {
+		a.searchMatchIndex = (a.searchMatchIndex - 1 + len(a.searchMatchIndices)) % len(a.searchMatchIndices)
+	}
+	a.jumpToSearchMatch(a.searchMatchIndices[a.searchMatchIndex])
+}
+
+// renderSearchInputPrompt renders the prompt of the search input with the search modes
+func (a *App) renderSearchInputPrompt(searchErr error) string {
+	mode := ""
+	if a.searchRegexMode {
+		mode += "[regex] "
+	}
+	if a.searchCaseSensitive {
+		mode += "[case] "
+	}
+	prompt := a.styles.GetHelp().Render(mode + "/" + a.searchInputBuffer + "_  (Enter: search, ↑/↓: history, ~: regex, Ctrl+S/Alt+C: case, n/N: next/prev match, Esc: reset)")
+	if searchErr != nil {
+		prompt += "  " + a.styles.StatusFailure.Render("invalid regex: "+searchErr.Error())
+	}
+	return prompt
+}
+
 // handleSearchInput handles search input mode
 func (a *App) handleSearchInput(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	// Ctrl+S / Alt+Cで大文字小文字の区別を切り替える(セッション中は維持)
+	if msg.Type == tea.KeyCtrlS || msg.String() == "alt+c" {
+		a.searchCaseSensitive = !a.searchCaseSensitive
+		return a, nil
+	}
+
 	switch msg.Type {
 	case tea.KeyRunes:
+		// ~で文字列検索と正規表現検索を切り替える
+		if msg.String() == "~" {
+			a.searchRegexMode = !a.searchRegexMode
+			return a, nil
+		}
 		a.searchInputBuffer += msg.String()
 	case tea.KeyBackspace:
 		if len(a.searchInputBuffer) > 0 {
@@ -1550,41 +3975,112 @@ func (a *App) handleSearchInput(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		}
 	case tea.KeyEnter:
 		// 検索して一致行リストを作成し、最初の一致行にジャンプ
-		lines := strings.Split(a.logs, "\n")
 		query := a.searchInputBuffer
-		a.searchMatchIndices = nil
-		for i, line := range lines {
-			if strings.Contains(strings.ToLower(line), strings.ToLower(query)) {
-				a.searchMatchIndices = append(a.searchMatchIndices, i)
-			}
+		matchLine, err := a.logSearchMatcher(query)
+		if err != nil {
+			// 不正な正規表現の場合は入力モードのままプロンプトにエラーを表示する
+			return a, nil
 		}
+		a.searchMatchIndices = matchingLines(a.searchTargetLines(), matchLine)
 		if len(a.searchMatchIndices) > 0 {
 			a.searchMatchIndex = 0
 			// 画面の先頭に来るように
-			maxOffset := len(lines) - (a.height - 6)
-			if maxOffset < 0 {
-				maxOffset = 0
-			}
-			offset := a.searchMatchIndices[0]
-			if offset > maxOffset {
-				offset = maxOffset
-			}
-			a.logOffset = offset
+			a.jumpToSearchMatch(a.searchMatchIndices[0])
 		} else {
 			a.searchMatchIndex = -1
 		}
 		a.searchInputMode = false
 		a.searchActiveQuery = a.searchInputBuffer // ハイライト維持
+		a.updateSearchNonMatches()
+		a.searchHistory = history.Add(a.searchHistory, query)
+		a.searchHistoryIndex = -1
+	case tea.KeyUp:
+		// ↑で古い検索語へ
+		if a.searchHistoryIndex < len(a.searchHistory)-1 {
+			a.searchHistoryIndex++
+			a.searchInputBuffer = a.searchHistory[a.searchHistoryIndex]
+		}
+	case tea.KeyDown:
+		// ↓で新しい検索語へ、最後は空の入力に戻る
+		if a.searchHistoryIndex >= 0 {
+			a.searchHistoryIndex--
+			if a.searchHistoryIndex >= 0 {
+				a.searchInputBuffer = a.searchHistory[a.searchHistoryIndex]
+			} else {
+				a.searchInputBuffer = ""
+			}
+		}
 	case tea.KeyEsc:
 		a.searchInputMode = false
 		a.searchInputBuffer = ""
 		a.searchActiveQuery = "" // エスケープ時は必ずハイライトも消す
 		a.searchMatchIndices = nil
 		a.searchMatchIndex = -1
+		a.clearSearchInvert()
 	}
 	return a, nil
 }
 
+// isSearchContextLine reports whether the line at index is within searchContextLines of a search match
+// without being a match itself
+func (a *App) isSearchContextLine(index int) bool {
+	if a.searchContextLines == 0 || len(a.searchMatchIndices) == 0 {
+		return false
+	}
+
+	// searchMatchIndicesは昇順なので二分探索で最も近いヒット行を探す
+	pos := sort.SearchInts(a.searchMatchIndices, index)
+	if pos < len(a.searchMatchIndices) && a.searchMatchIndices[pos] == index {
+		return false
+	}
+	if pos < len(a.searchMatchIndices) && a.searchMatchIndices[pos]-index <= a.searchContextLines {
+		return true
+	}
+	return pos > 0 && index-a.searchMatchIndices[pos-1] <= a.searchContextLines
+}
+
+// logSearchMatcher returns a function reporting the byte range of the first match of query in a line,
+// or nil when the line does not match. In regex mode query is compiled as a regular expression.
+// Matching ignores case unless searchCaseSensitive is set.
+func (a *App) logSearchMatcher(query string) (func(string) []int, error) {
+	if a.searchRegexMode {
+		if !a.searchCaseSensitive {
+			query = "(?i)" + query
+		}
+		re, err := regexp.Compile(query)
+		if err != nil {
+			return nil, err
+		}
+		return func(line string) []int {
+			loc := re.FindStringIndex(line)
+			// 空文字列へのマッチは一致とみなさない
+			if loc == nil || loc[0] == loc[1] {
+				return nil
+			}
+			return loc
+		}, nil
+	}
+
+	if a.searchCaseSensitive {
+		return func(line string) []int {
+			idx := strings.Index(line, query)
+			if idx < 0 {
+				return nil
+			}
+			return []int{idx, idx + len(query)}
+		}, nil
+	}
+
+	lowerQuery := strings.ToLower(query)
+	return func(line string) []int {
+		idx := strings.Index(strings.ToLower(line), lowerQuery)
+		if idx < 0 {
+			return nil
+		}
+		return []int{idx, idx + len(query)}
+	}, nil
+}
+
 // handleJumpInput handles jump input mode
 func (a *App) handleJumpInput(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	switch msg.Type {
@@ -1599,16 +4095,7 @@ func (a *App) handleJumpInput(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		}
 	case tea.KeyEnter:
 		if n, err := strconv.Atoi(a.jumpInputBuffer); err == nil && n > 0 {
-			lines := strings.Split(a.logs, "\n")
-			maxOffset := len(lines) - (a.height - 6)
-			if maxOffset < 0 {
-				maxOffset = 0
-			}
-			offset := n - 1
-			if offset > maxOffset {
-				offset = maxOffset
-			}
-			a.logOffset = offset
+			a.jumpToLogLine(n - 1)
 		}
 		a.jumpInputMode = false
 		a.jumpInputBuffer = ""
@@ -1618,3 +4105,125 @@ func (a *App) handleJumpInput(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	}
 	return a, nil
 }
+
+// handleSaveInput handles log save path input mode
+func (a *App) handleSaveInput(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyRunes, tea.KeySpace, tea.KeyBackspace:
+		a.saveInputBuffer = editInputBuffer(a.saveInputBuffer, msg)
+	case tea.KeyEnter:
+		path := strings.TrimSpace(a.saveInputBuffer)
+		a.saveInputMode = false
+		a.saveInputBuffer = ""
+		if path == "" {
+			return a, nil
+		}
+		return a, a.saveLogsToFile(path, a.logs)
+	case tea.KeyEsc:
+		a.saveInputMode = false
+		a.saveInputBuffer = ""
+	}
+	return a, nil
+}
+
+// handleListFilterInput handles fuzzy filter input mode in list views
+func (a *App) handleListFilterInput(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	filter := a.currentListFilter()
+	if filter == nil {
+		a.listFilterInputMode = false
+		return a, nil
+	}
+
+	switch msg.Type {
+	case tea.KeyRunes, tea.KeySpace, tea.KeyBackspace:
+		filter.query = editInputBuffer(filter.query, msg)
+	case tea.KeyEnter:
+		a.listFilterInputMode = false
+		filter.hide = filter.query != ""
+	case tea.KeyEsc:
+		a.listFilterInputMode = false
+		*filter = listFilter{}
+	default:
+		return a, nil
+	}
+
+	// 入力のたびに一覧を更新する
+	a.applyListFilter()
+	if a.viewState == WorkflowListView {
+		return a, a.loadSelectedWorkflowDetails()
+	}
+	return a, a.loadSelectedRunJobs()
+}
+
+// selectedRun returns the run selected in the current run list, or nil outside run list views
+func (a *App) selectedRun() *models.WorkflowRun {
+	switch a.viewState {
+	case AllRunsView:
+		return selectedRunInList(a.allRunsList)
+	case WorkflowRunsView:
+		return selectedRunInList(a.runsList)
+	case BranchRunsView:
+		return selectedRunInList(a.branchRunsList)
+	case PRRunsView:
+		return selectedRunInList(a.prRunsList)
+	}
+	return nil
+}
+
+// isRunListView reports whether the current view is one of the run lists
+func (a *App) isRunListView() bool {
+	return a.viewState == AllRunsView || a.viewState == WorkflowRunsView || a.viewState == BranchRunsView
+}
+
+// loadSelectedRunJobs schedules a jobs load for the run selected in the current list
+func (a *App) loadSelectedRunJobs() tea.Cmd {
+	run := a.selectedRun()
+	if run == nil {
+		return nil
+	}
+	return a.loadWorkflowRunJobs(run.ID)
+}
+
+// handleBranchInput handles branch name input mode
+func (a *App) handleBranchInput(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyEnter:
+		branch := strings.TrimSpace(a.branchInputBuffer)
+		a.branchInputMode = false
+		a.branchInputBuffer = ""
+		if branch == "" {
+			return a, nil
+		}
+		a.branchFilter = branch
+		a.branchRunsPage = 1
+		a.branchRunsList.ResetSelected()
+		a.viewState = BranchRunsView
+		a.loading = true
+		a.updateListSizes()
+		return a, a.loadBranchRunsPaginated()
+	case tea.KeyEsc:
+		a.branchInputMode = false
+		a.branchInputBuffer = ""
+		// --branch の絞り込みを解除して全ブランチのランに戻す
+		if a.defaultBranchFilter != "" && a.viewState == AllRunsView {
+			a.defaultBranchFilter = ""
+			return a, a.reloadFilteredRuns()
+		}
+	default:
+		a.branchInputBuffer = editInputBuffer(a.branchInputBuffer, msg)
+	}
+	return a, nil
+}
+
+// editInputBuffer applies a text editing key (character input or backspace) to an input buffer
+func editInputBuffer(buffer string, msg tea.KeyMsg) string {
+	switch msg.Type {
+	case tea.KeyRunes, tea.KeySpace:
+		return buffer + msg.String()
+	case tea.KeyBackspace:
+		if runes := []rune(buffer); len(runes) > 0 {
+			return string(runes[:len(runes)-1])
+		}
+	}
+	return buffer
+}
@@ -0,0 +1,474 @@
+package tui
+
+import (
+	"errors"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+	"github.com/ryo246912/gh-actions-dash/internal/bookmarks"
+	"github.com/ryo246912/gh-actions-dash/internal/config"
+	"github.com/ryo246912/gh-actions-dash/internal/github"
+	"github.com/ryo246912/gh-actions-dash/internal/models"
+)
+
+// jobsCacheImpl is the interface shared by the jobs cache implementations compared in the benchmarks
+type jobsCacheImpl interface {
+	Get(runID int64) ([]models.Job, bool)
+	Set(runID int64, jobs []models.Job, ttl time.Duration)
+	Cleanup()
+}
+
+// mutexJobsCache adapts the RWMutex based ttlCache to the JobsCache method set
+type mutexJobsCache struct {
+	*ttlCache[int64, []models.Job]
+}
+
+func (c mutexJobsCache) Set(runID int64, jobs []models.Job, ttl time.Duration) {
+	c.SetWithTTL(runID, jobs, ttl)
+}
+
+// jobsCacheImpls returns constructors of the jobs cache implementations
+func jobsCacheImpls() map[string]func(maxEntries int) jobsCacheImpl {
+	return map[string]func(maxEntries int) jobsCacheImpl{
+		"SyncMap": func(maxEntries int) jobsCacheImpl {
+			return NewJobsCacheWithOptions(completedJobsCacheTTL, maxEntries)
+		},
+		"RWMutex": func(maxEntries int) jobsCacheImpl {
+			return mutexJobsCache{newTTLCache[int64, []models.Job](completedJobsCacheTTL, maxEntries)}
+		},
+	}
+}
+
+const benchmarkRuns = 100
+
+func BenchmarkJobsCacheGet(b *testing.B) {
+	for name, newCache := range jobsCacheImpls() {
+		b.Run(name, func(b *testing.B) {
+			cache := newCache(defaultJobsCacheMaxEntries)
+			jobs := []models.Job{{ID: 1}}
+			for runID := int64(0); runID < benchmarkRuns; runID++ {
+				cache.Set(runID, jobs, 0)
+			}
+			b.ResetTimer()
+			b.RunParallel(func(pb *testing.PB) {
+				var runID int64
+				for pb.Next() {
+					cache.Get(runID % benchmarkRuns)
+					runID++
+				}
+			})
+		})
+	}
+}
+
+func BenchmarkJobsCacheSet(b *testing.B) {
+	for name, newCache := range jobsCacheImpls() {
+		b.Run(name, func(b *testing.B) {
+			cache := newCache(defaultJobsCacheMaxEntries)
+			jobs := []models.Job{{ID: 1}}
+			b.ResetTimer()
+			b.RunParallel(func(pb *testing.PB) {
+				var runID int64
+				for pb.Next() {
+					cache.Set(runID%benchmarkRuns, jobs, 0)
+					runID++
+				}
+			})
+		})
+	}
+}
+
+func BenchmarkJobsCacheGetSet(b *testing.B) {
+	for name, newCache := range jobsCacheImpls() {
+		b.Run(name, func(b *testing.B) {
+			cache := newCache(defaultJobsCacheMaxEntries)
+			jobs := []models.Job{{ID: 1}}
+			b.ResetTimer()
+			b.RunParallel(func(pb *testing.PB) {
+				var runID int64
+				for pb.Next() {
+					// 読み込み9回につき書き込み1回
+					if runID%10 == 0 {
+						cache.Set(runID%benchmarkRuns, jobs, 0)
+					} else {
+						cache.Get(runID % benchmarkRuns)
+					}
+					runID++
+				}
+			})
+		})
+	}
+}
+
+func BenchmarkJobsCacheCleanup(b *testing.B) {
+	for name, newCache := range jobsCacheImpls() {
+		b.Run(name, func(b *testing.B) {
+			cache := newCache(defaultJobsCacheMaxEntries)
+			jobs := []models.Job{{ID: 1}}
+			for runID := int64(0); runID < benchmarkRuns; runID++ {
+				cache.Set(runID, jobs, 0)
+			}
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				cache.Cleanup()
+			}
+		})
+	}
+}
+
+func TestJobsCacheCleanup(t *testing.T) {
+	cache := NewJobsCacheWithOptions(completedJobsCacheTTL, 0)
+	cache.Set(1, []models.Job{{ID: 1}}, time.Nanosecond)
+	cache.Set(2, []models.Job{{ID: 2}}, time.Hour)
+	time.Sleep(time.Millisecond)
+
+	cache.Cleanup()
+
+	if _, found := cache.Get(1); found {
+		t.Error("expired entry of run 1 is still cached")
+	}
+	if _, found := cache.Get(2); !found {
+		t.Error("entry of run 2 was removed before it expired")
+	}
+	if size := cache.size.Load(); size != 1 {
+		t.Errorf("size = %d, want 1", size)
+	}
+}
+
+func TestJobsCacheCleanupKeepsReplacedEntry(t *testing.T) {
+	cache := NewJobsCacheWithOptions(completedJobsCacheTTL, 0)
+	cache.Set(1, []models.Job{{ID: 1}}, time.Nanosecond)
+	time.Sleep(time.Millisecond)
+
+	// Cleanupが期限切れと判断した後に新しいエントリへ差し替えられた場合を再現する
+	value, _ := cache.entries.Load(int64(1))
+	pointer := value.(*atomic.Pointer[JobsCacheEntry])
+	expired := pointer.Load()
+	cache.Set(1, []models.Job{{ID: 2}}, time.Hour)
+	cache.remove(1, pointer, expired)
+
+	jobs, found := cache.Get(1)
+	if !found || jobs[0].ID != 2 {
+		t.Errorf("Get(1) = %v, %v, want the replaced entry", jobs, found)
+	}
+}
+
+func TestJobsCacheEvictsOldestEntry(t *testing.T) {
+	const maxEntries = 3
+	cache := NewJobsCacheWithOptions(completedJobsCacheTTL, maxEntries)
+	for runID := int64(1); runID <= maxEntries+1; runID++ {
+		cache.Set(runID, []models.Job{{ID: runID}}, 0)
+		// タイムスタンプの順序を確定させる
+		time.Sleep(time.Millisecond)
+	}
+
+	if _, found := cache.Get(1); found {
+		t.Error("oldest entry of run 1 was not evicted")
+	}
+	for runID := int64(2); runID <= maxEntries+1; runID++ {
+		if _, found := cache.Get(runID); !found {
+			t.Errorf("entry of run %d was evicted", runID)
+		}
+	}
+	if size := cache.size.Load(); size != maxEntries {
+		t.Errorf("size = %d, want %d", size, maxEntries)
+	}
+}
+
+func TestWorkflowCommand(t *testing.T) {
+	tests := []struct {
+		line string
+		want string
+	}{
+		{"::notice::Deployed to staging", "notice"},
+		{"::error::Build failed", "error"},
+		{"::warning::Deprecated input", "warning"},
+		{"::debug::cache key=abc", "debug"},
+		{"::error file=app.js,line=1,col=5::Missing semicolon", "error"},
+		{"::warning title=Deprecated: set-output::Use GITHUB_OUTPUT", "warning"},
+		{"::notice file=README.md::", "notice"},
+		{"2024-01-15T10:00:00.1234567Z ::error::Build failed", "error"},
+		{"2024-01-15T10:00:00.1234567Z ::notice title=Done::All checks passed", "notice"},
+		{"  ::debug::indented", "debug"},
+		// 通常の出力に含まれる"::"は対象外
+		{"error[E0433]: failed to resolve: use of undeclared type `module::error::AppError`", ""},
+		{"2024-01-15T10:00:00.1234567Z thread 'main' panicked at src/module::error::run", ""},
+		{"::group::Run tests", ""},
+		{"::errors::not a command", ""},
+		{"::error", ""},
+		{"##[error]Process completed with exit code 1.", ""},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		if got := workflowCommand(tt.line); got != tt.want {
+			t.Errorf("workflowCommand(%q) = %q, want %q", tt.line, got, tt.want)
+		}
+	}
+}
+
+func TestViewNoColor(t *testing.T) {
+	// テスト環境は端末ではないので、色が出力される状態を明示的に作る
+	lipgloss.SetColorProfile(termenv.TrueColor)
+	t.Cleanup(func() { lipgloss.SetColorProfile(termenv.Ascii) })
+
+	runs := []models.WorkflowRun{
+		{ID: 1, Name: "CI", HeadBranch: "main", Status: "completed", Conclusion: "success", CreatedAt: time.Now()},
+		{ID: 2, Name: "Deploy", HeadBranch: "main", Status: "completed", Conclusion: "failure", CreatedAt: time.Now()},
+	}
+	// コマンドは実行しないのでAPIへのリクエストは発生しない
+	t.Setenv("GH_TOKEN", "test-token")
+	client, err := github.NewClientWithHostname("github.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	render := func(noColor bool) string {
+		app := NewApp(client, "owner", "repo", config.DefaultKeyMap(), Options{NoColor: noColor})
+		app.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+		app.Update(allRunsLoadedMsg{runs: runs})
+		return app.View()
+	}
+
+	if colored := render(false); !strings.Contains(colored, "\x1b[") {
+		t.Fatal("View() without --no-color contains no ANSI sequence; the test cannot detect styling")
+	}
+	if plain := render(true); strings.Contains(plain, "\x1b[") {
+		t.Errorf("View() with --no-color contains an ANSI sequence:\n%q", plain)
+	}
+}
+
+func TestRenderRunAttemptsGroupsJobsByAttempt(t *testing.T) {
+	app := NewApp(nil, "owner", "repo", config.DefaultKeyMap(), Options{NoColor: true})
+	app.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	app.showRunAttempts = true
+	app.runAttemptsRun = models.WorkflowRun{ID: 1, Name: "CI", RunNumber: 7, RunAttempt: 2}
+	app.Update(runAttemptsLoadedMsg{
+		runID: 1,
+		attempts: []models.WorkflowRun{
+			{ID: 1, Status: "completed", Conclusion: "success", RunAttempt: 2},
+			{ID: 1, Status: "completed", Conclusion: "failure", RunAttempt: 1},
+		},
+		jobs: []models.Job{
+			{Name: "test (retried)", Status: "completed", Conclusion: "success", RunAttempt: 2},
+			{Name: "test (flaky)", Status: "completed", Conclusion: "failure", RunAttempt: 1},
+		},
+	})
+
+	view := app.renderRunAttemptsView()
+	second := strings.Index(view, "Attempt 2")
+	first := strings.Index(view, "Attempt 1")
+	retried := strings.Index(view, "test (retried)")
+	flaky := strings.Index(view, "test (flaky)")
+	if second < 0 || first < 0 || retried < 0 || flaky < 0 {
+		t.Fatalf("view is missing an attempt or a job:\n%s", view)
+	}
+	if !(second < retried && retried < first && first < flaky) {
+		t.Errorf("jobs are not listed under their attempt:\n%s", view)
+	}
+
+	// 別のランの結果は捨てる
+	app.Update(runAttemptsLoadedMsg{runID: 2, err: errors.New("stale")})
+	if app.runAttemptsErr != nil {
+		t.Error("result for another run was stored")
+	}
+}
+
+// keyPress returns the key message of a key name such as "ctrl+d", "esc", "enter" or a printable character
+func keyPress(name string) tea.KeyMsg {
+	switch name {
+	case "ctrl+d":
+		return tea.KeyMsg{Type: tea.KeyCtrlD}
+	case "esc":
+		return tea.KeyMsg{Type: tea.KeyEsc}
+	case "enter":
+		return tea.KeyMsg{Type: tea.KeyEnter}
+	}
+	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(name)}
+}
+
+func TestDebugLogViewSharesLogSearch(t *testing.T) {
+	t.Setenv("GH_TOKEN", "test-token")
+	client, err := github.NewClientWithHostname("github.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.EnableDebugLog()
+
+	app := NewApp(client, "owner", "repo", config.DefaultKeyMap(), Options{NoColor: true})
+	app.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	app.viewState = WorkflowRunLogsView
+	app.logs = "setup\nbuild failed\ncleanup"
+	app.searchActiveQuery = "failed"
+	app.searchMatchIndices = []int{1}
+	app.searchMatchIndex = 0
+
+	// --debug時はログ表示中でもctrl+dでデバッグログを開く
+	app.Update(keyPress("ctrl+d"))
+	if app.viewState != DebugLogView {
+		t.Fatalf("viewState = %v, want DebugLogView", app.viewState)
+	}
+	if app.searchActiveQuery != "" {
+		t.Errorf("search of the log view %q is still active in the debug log view", app.searchActiveQuery)
+	}
+
+	app.debugEntries = []github.DebugEntry{
+		{Method: "GET", URL: "https://api.github.com/repos/owner/repo/actions/runs", Status: 200},
+		{Method: "GET", URL: "https://api.github.com/user", Status: 200},
+	}
+	for _, name := range []string{"/", "r", "u", "n", "s", "enter"} {
+		app.Update(keyPress(name))
+	}
+	// 新しいリクエストが先頭に表示される
+	if len(app.searchMatchIndices) != 1 || app.searchMatchIndices[0] != 1 {
+		t.Errorf("searchMatchIndices = %v, want [1]", app.searchMatchIndices)
+	}
+
+	// Escで検索を解除し、もう一度Escでログに戻ると元の検索が復元される
+	app.Update(keyPress("esc"))
+	app.Update(keyPress("esc"))
+	if app.viewState != WorkflowRunLogsView {
+		t.Fatalf("viewState = %v, want WorkflowRunLogsView", app.viewState)
+	}
+	if app.searchActiveQuery != "failed" || len(app.searchMatchIndices) != 1 {
+		t.Errorf("log search = %q %v, want the search before opening the debug log", app.searchActiveQuery, app.searchMatchIndices)
+	}
+}
+
+func TestWorkflowEnvOverlayFromLogView(t *testing.T) {
+	app := NewApp(nil, "owner", "repo", config.DefaultKeyMap(), Options{NoColor: true})
+	app.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	app.viewState = WorkflowRunLogsView
+	app.logs = "line"
+	app.currentRun = &models.WorkflowRun{ID: 1, Name: "CI", RunNumber: 3, Path: ".github/workflows/ci.yml", HeadSha: "abc"}
+	// キャッシュ済みならAPIを呼ばずに表示する
+	app.workflowFileCache[".github/workflows/ci.yml@abc"] = `env:
+  CI: "true"
+jobs:
+  deploy:
+    env:
+      DEPLOY_TOKEN: ${{ secrets.DEPLOY_TOKEN }}
+    steps:
+      - name: Build
+        env:
+          NODE_ENV: production
+`
+
+	if _, cmd := app.Update(keyPress("E")); cmd != nil {
+		t.Error("opening the env overlay of a cached workflow file returned a command")
+	}
+	view := app.View()
+	for _, want := range []string{"Env: CI", "🔒DEPLOY_TOKEN", "Env: NODE_ENV"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("env overlay does not contain %q:\n%s", want, view)
+		}
+	}
+	if strings.Contains(view, "production") || strings.Contains(view, "secrets.") {
+		t.Errorf("env overlay shows a value:\n%s", view)
+	}
+
+	app.Update(keyPress("esc"))
+	if app.showWorkflowEnv {
+		t.Error("Esc did not close the env overlay")
+	}
+}
+
+func TestLogsLoadedForAnotherRunAreDropped(t *testing.T) {
+	app := NewApp(nil, "owner", "repo", config.DefaultKeyMap(), Options{NoColor: true})
+	app.viewState = WorkflowRunLogsView
+	app.loading = true
+	app.currentRun = &models.WorkflowRun{ID: 2}
+
+	// 前に開いていたランの結果は表示中のランに反映しない
+	app.Update(logsLoadedMsg{runID: 1, logs: &models.RunLogs{Content: "old run"}})
+	app.Update(logsLoadedMsg{runID: 1, err: errors.New("old run failed")})
+	if app.logs != "" || app.err != nil || !app.loading {
+		t.Fatalf("stale result applied: logs = %q, err = %v, loading = %v", app.logs, app.err, app.loading)
+	}
+
+	app.Update(logsLoadedMsg{runID: 2, logs: &models.RunLogs{Content: "current run"}})
+	if app.logs != "current run" || app.loading {
+		t.Errorf("logs = %q, loading = %v, want the current run's logs", app.logs, app.loading)
+	}
+}
+
+func TestTokenInfoKeyDoesNotCollideWithRunMetadata(t *testing.T) {
+	app := NewApp(nil, "owner", "repo", config.DefaultKeyMap(), Options{NoColor: true})
+	app.viewState = WorkflowRunLogsView
+	app.currentRun = &models.WorkflowRun{ID: 1}
+
+	app.Update(keyPress("i"))
+	if !app.showRunMeta || app.showInfo {
+		t.Fatalf("i: showRunMeta = %v, showInfo = %v, want only the run metadata", app.showRunMeta, app.showInfo)
+	}
+	app.showInfo = true
+	app.Update(keyPress("I"))
+	if app.showInfo {
+		t.Error("I did not close the token info panel")
+	}
+}
+
+func TestRenderBookmarkListTruncatesByRunes(t *testing.T) {
+	store := bookmarks.New(t.TempDir() + "/bookmarks.json")
+	app := NewApp(nil, "owner", "repo", config.DefaultKeyMap(), Options{NoColor: true, Bookmarks: store})
+	app.Update(tea.WindowSizeMsg{Width: 30, Height: 20})
+	app.currentRun = &models.WorkflowRun{ID: 1}
+	app.logs = "\x1b[31m" + strings.Repeat("エラー", 10) + "\x1b[0m"
+	if _, err := store.Add(1, 1); err != nil {
+		t.Fatal(err)
+	}
+
+	list := app.renderBookmarkList()
+	if !utf8.ValidString(list) || strings.Contains(list, "\x1b[31") {
+		t.Fatalf("bookmark list cut a rune or an escape sequence: %q", list)
+	}
+	if !strings.Contains(list, "| "+strings.Repeat("エラー", 4)+"エラ...") {
+		t.Errorf("bookmark list = %q, want the line truncated to the width", list)
+	}
+}
+
+func TestRenderJobSidebarTruncatesByRunes(t *testing.T) {
+	app := NewApp(nil, "owner", "repo", config.DefaultKeyMap(), Options{NoColor: true})
+	app.currentJobs = []models.Job{{ID: 1, Name: strings.Repeat("デプロイ", 20), Status: "completed", Conclusion: "success"}}
+
+	sidebar := app.renderJobSidebar(20)
+	if !utf8.ValidString(sidebar) {
+		t.Fatalf("job sidebar cut a rune: %q", sidebar)
+	}
+	if !strings.Contains(sidebar, "...") {
+		t.Errorf("job sidebar = %q, want the job name truncated", sidebar)
+	}
+}
+
+func TestRenderArtifactsViewTruncatesByRunes(t *testing.T) {
+	app := NewApp(nil, "owner", "repo", config.DefaultKeyMap(), Options{NoColor: true})
+	app.Update(tea.WindowSizeMsg{Width: 50, Height: 20})
+	app.currentRun = &models.WorkflowRun{ID: 1, RunNumber: 3}
+	app.artifacts = []models.Artifact{{ID: 1, Name: strings.Repeat("成果物", 10)}}
+
+	view := app.renderArtifactsView()
+	if !utf8.ValidString(view) {
+		t.Fatalf("artifacts view cut a rune: %q", view)
+	}
+	if !strings.Contains(view, "成果物成果物成...") {
+		t.Errorf("artifacts view = %q, want the name truncated to 10 runes", view)
+	}
+}
+
+func TestRenderCachesViewTruncatesByRunes(t *testing.T) {
+	app := NewApp(nil, "owner", "repo", config.DefaultKeyMap(), Options{NoColor: true})
+	app.Update(tea.WindowSizeMsg{Width: 70, Height: 20})
+	app.caches = []models.ActionsCacheEntry{{ID: 1, Key: strings.Repeat("キャッシュ", 10), Ref: "refs/heads/main"}}
+
+	view := app.renderCachesView()
+	if !utf8.ValidString(view) {
+		t.Fatalf("caches view cut a rune: %q", view)
+	}
+	if !strings.Contains(view, "キャッシュキャ...") {
+		t.Errorf("caches view = %q, want the key truncated to 10 runes", view)
+	}
+}
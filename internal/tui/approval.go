@@ -0,0 +1,172 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/ryo246912/gh-actions-dash/internal/models"
+)
+
+type approvalDeploymentsLoadedMsg struct {
+	run         models.WorkflowRun
+	deployments []models.PendingDeployment
+	err         error
+}
+
+type deploymentReviewedMsg struct {
+	runID       int64
+	environment string
+	approve     bool
+	err         error
+}
+
+// loadApprovalDeployments fetches the deployments of a run that are waiting for approval
+func (a *App) loadApprovalDeployments(run models.WorkflowRun) tea.Cmd {
+	return tea.Cmd(func() tea.Msg {
+		deployments, err := a.client.GetPendingDeployments(a.owner, a.repo, run.ID)
+		return approvalDeploymentsLoadedMsg{run: run, deployments: deployments, err: err}
+	})
+}
+
+// openApprovalDialog opens the environment selection dialog for pending deployments
+func (a *App) openApprovalDialog(msg approvalDeploymentsLoadedMsg) (tea.Model, tea.Cmd) {
+	if msg.err != nil {
+		return a, a.flashStatus(fmt.Sprintf("Failed to get pending deployments: %v", msg.err))
+	}
+	if len(msg.deployments) == 0 {
+		return a, a.flashStatus(fmt.Sprintf("Run #%d has no deployments awaiting approval", msg.run.RunNumber))
+	}
+
+	a.approvalMode = true
+	a.approvalRun = msg.run
+	a.approvalDeployments = msg.deployments
+	a.approvalIndex = 0
+	a.approvalCommentMode = false
+	a.approvalCommentBuffer = ""
+	a.updateListSizes()
+	return a, nil
+}
+
+// closeApprovalDialog closes the approval dialog
+func (a *App) closeApprovalDialog() {
+	a.approvalMode = false
+	a.approvalDeployments = nil
+	a.approvalCommentMode = false
+	a.approvalCommentBuffer = ""
+	a.updateListSizes()
+}
+
+// handleApprovalInput handles the approval dialog (environment selection, then comment input)
+func (a *App) handleApprovalInput(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if a.approvalCommentMode {
+		switch msg.Type {
+		case tea.KeyEnter:
+			return a.submitDeploymentReview()
+		case tea.KeyEsc:
+			// 環境選択に戻る
+			a.approvalCommentMode = false
+			a.approvalCommentBuffer = ""
+		default:
+			a.approvalCommentBuffer = editInputBuffer(a.approvalCommentBuffer, msg)
+		}
+		return a, nil
+	}
+
+	switch {
+	case key.Matches(msg, a.keyMap.Up):
+		if a.approvalIndex > 0 {
+			a.approvalIndex--
+		}
+	case key.Matches(msg, a.keyMap.Down):
+		if a.approvalIndex < len(a.approvalDeployments)-1 {
+			a.approvalIndex++
+		}
+	case msg.String() == "a" || msg.String() == "r":
+		a.approvalApprove = msg.String() == "a"
+		a.approvalCommentMode = true
+		a.approvalCommentBuffer = ""
+	case key.Matches(msg, a.keyMap.Back):
+		a.closeApprovalDialog()
+	}
+	return a, nil
+}
+
+// submitDeploymentReview approves or rejects the selected environment
+func (a *App) submitDeploymentReview() (tea.Model, tea.Cmd) {
+	run := a.approvalRun
+	environment := a.approvalDeployments[a.approvalIndex].Environment
+	approve := a.approvalApprove
+	comment := strings.TrimSpace(a.approvalCommentBuffer)
+	a.closeApprovalDialog()
+
+	return a, tea.Cmd(func() tea.Msg {
+		var err error
+		if approve {
+			err = a.client.ApproveDeployment(a.owner, a.repo, run.ID, []int64{environment.ID}, comment)
+		} else {
+			err = a.client.RejectDeployment(a.owner, a.repo, run.ID, []int64{environment.ID}, comment)
+		}
+		return deploymentReviewedMsg{runID: run.ID, environment: environment.Name, approve: approve, err: err}
+	})
+}
+
+// handleDeploymentReviewed reports the review result and refreshes the run list
+func (a *App) handleDeploymentReviewed(msg deploymentReviewedMsg) (tea.Model, tea.Cmd) {
+	if msg.err != nil {
+		return a, a.flashStatus(fmt.Sprintf("Failed to review %s: %v", msg.environment, msg.err))
+	}
+
+	verb := "Rejected"
+	if msg.approve {
+		verb = "Approved"
+	}
+
+	// 状態が変わるのでジョブと承認待ちを再取得させる
+	a.jobsCache.Delete(msg.runID)
+	a.pendingDeploymentsMutex.Lock()
+	delete(a.pendingDeployments, msg.runID)
+	a.pendingDeploymentsMutex.Unlock()
+
+	status := a.flashStatus(fmt.Sprintf("%s deployment to %s", verb, msg.environment))
+	if a.viewState == WorkflowRunLogsView {
+		return a, tea.Batch(status, a.loadWorkflowRunJobs(msg.runID))
+	}
+	_, refreshCmd := a.refresh()
+	return a, tea.Batch(status, refreshCmd)
+}
+
+// renderApprovalDialog renders the pending deployment approval dialog
+func (a *App) renderApprovalDialog() string {
+	lines := []string{a.styles.GetTitle().Render(fmt.Sprintf("⏳ Review deployments - Run #%d", a.approvalRun.RunNumber))}
+
+	for i, deployment := range a.approvalDeployments {
+		var reviewers []string
+		for _, reviewer := range deployment.Reviewers {
+			reviewers = append(reviewers, reviewer.DisplayName())
+		}
+		line := deployment.Environment.Name
+		if len(reviewers) > 0 {
+			line += "  (" + strings.Join(reviewers, ", ") + ")"
+		}
+		if i == a.approvalIndex {
+			lines = append(lines, a.styles.HelpKey.Render("> "+line))
+		} else {
+			lines = append(lines, a.styles.HelpDesc.Render("  "+line))
+		}
+	}
+
+	if a.approvalCommentMode {
+		action := "Reject"
+		if a.approvalApprove {
+			action = "Approve"
+		}
+		lines = append(lines, a.styles.HelpDesc.Render(action+" comment: "+a.approvalCommentBuffer+"_  (Enter to submit / Esc to go back)"))
+	} else {
+		lines = append(lines, a.styles.HelpDesc.Render("↑/↓: Select environment • a: Approve • r: Reject • Esc: Cancel"))
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left, lines...)
+}
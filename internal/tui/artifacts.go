@@ -0,0 +1,250 @@
+package tui
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/ryo246912/gh-actions-dash/internal/models"
+	"github.com/ryo246912/gh-actions-dash/internal/tui/components"
+)
+
+type artifactsLoadedMsg struct {
+	runID     int64
+	artifacts []models.Artifact
+	err       error
+}
+
+type artifactDownloadedMsg struct {
+	name string
+	path string
+	err  error
+}
+
+type artifactDownloadTickMsg struct{}
+
+// artifactDownloadTickInterval is how often the download progress bar is redrawn
+const artifactDownloadTickInterval = 100 * time.Millisecond
+
+// openArtifactsView switches to the artifact list of the current run
+func (a *App) openArtifactsView() (tea.Model, tea.Cmd) {
+	if a.currentRun == nil {
+		return a, nil
+	}
+	a.viewState = ArtifactsView
+	a.artifactIndex = 0
+	if cached, ok := a.artifactsCache.Get(a.currentRun.ID); ok {
+		a.artifacts = cached
+		a.artifactsLoading = false
+		return a, nil
+	}
+	a.artifacts = nil
+	a.artifactsLoading = true
+	return a, a.loadArtifacts(a.currentRun.ID)
+}
+
+// loadArtifacts fetches the artifacts of a run
+func (a *App) loadArtifacts(runID int64) tea.Cmd {
+	return tea.Cmd(func() tea.Msg {
+		artifacts, err := a.client.GetWorkflowRunArtifacts(a.owner, a.repo, runID)
+		return artifactsLoadedMsg{runID: runID, artifacts: artifacts, err: err}
+	})
+}
+
+// handleArtifactsLoaded shows the fetched artifacts
+func (a *App) handleArtifactsLoaded(msg artifactsLoadedMsg) (tea.Model, tea.Cmd) {
+	// 別のランに移動済みなら捨てる
+	if a.currentRun == nil || a.currentRun.ID != msg.runID {
+		return a, nil
+	}
+	a.artifactsLoading = false
+	if msg.err != nil {
+		return a, a.flashStatus(fmt.Sprintf("Failed to get artifacts: %v", msg.err))
+	}
+	a.artifactsCache.Set(msg.runID, msg.artifacts)
+	a.artifacts = msg.artifacts
+	a.artifactIndex = 0
+	return a, nil
+}
+
+// handleArtifactsKey handles the keys of the artifact list
+func (a *App) handleArtifactsKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case key.Matches(msg, a.keyMap.Back, a.keyMap.Left):
+		return a.goBack()
+	case key.Matches(msg, a.keyMap.Up):
+		if a.artifactIndex > 0 {
+			a.artifactIndex--
+		}
+	case key.Matches(msg, a.keyMap.Down):
+		if a.artifactIndex < len(a.artifacts)-1 {
+			a.artifactIndex++
+		}
+	case key.Matches(msg, a.keyMap.Refresh) && a.currentRun != nil:
+		a.artifactsCache.Delete(a.currentRun.ID)
+		a.artifactsLoading = true
+		return a, a.loadArtifacts(a.currentRun.ID)
+	case key.Matches(msg, a.keyMap.Enter):
+		return a, a.downloadSelectedArtifact()
+	}
+	return a, nil
+}
+
+// downloadSelectedArtifact saves the selected artifact to ~/Downloads/<name>.zip
+func (a *App) downloadSelectedArtifact() tea.Cmd {
+	if a.artifactIndex >= len(a.artifacts) {
+		return nil
+	}
+	artifact := a.artifacts[a.artifactIndex]
+	if artifact.Expired {
+		return a.flashStatus(fmt.Sprintf("Artifact %s has expired", artifact.Name))
+	}
+	if a.artifactDownloading != nil {
+		return a.flashStatus(fmt.Sprintf("Still downloading %s", a.artifactDownloading.Name))
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return a.flashStatus(fmt.Sprintf("Failed to get home directory: %v", err))
+	}
+	// アーティファクト名にパス区切りが含まれても~/Downloads直下に保存する
+	path := filepath.Join(home, "Downloads", filepath.Base(artifact.Name)+".zip")
+
+	a.artifactDownloading = &artifact
+	a.artifactDownloadBytes.Store(0)
+	return tea.Batch(
+		a.scheduleArtifactDownloadTick(),
+		tea.Cmd(func() tea.Msg {
+			err := a.client.DownloadArtifact(a.owner, a.repo, artifact.ID, path, a.artifactDownloadBytes.Store)
+			return artifactDownloadedMsg{name: artifact.Name, path: path, err: err}
+		}),
+	)
+}
+
+// scheduleArtifactDownloadTick redraws the download progress bar periodically until the download finishes
+func (a *App) scheduleArtifactDownloadTick() tea.Cmd {
+	if a.artifactDownloading == nil {
+		return nil
+	}
+	return tea.Tick(artifactDownloadTickInterval, func(time.Time) tea.Msg {
+		return artifactDownloadTickMsg{}
+	})
+}
+
+// handleArtifactDownloaded reports the download result
+func (a *App) handleArtifactDownloaded(msg artifactDownloadedMsg) (tea.Model, tea.Cmd) {
+	a.artifactDownloading = nil
+	if msg.err != nil {
+		return a, a.flashStatus(fmt.Sprintf("Failed to download %s: %v", msg.name, msg.err))
+	}
+	return a, a.flashStatus(fmt.Sprintf("Saved %s to %s", msg.name, msg.path))
+}
+
+// renderArtifactsView renders the artifact list of the current run
+func (a *App) renderArtifactsView() string {
+	if a.currentRun == nil {
+		return "No run selected"
+	}
+
+	header := a.styles.GetTitle().Render(fmt.Sprintf("Artifacts - Run #%d", a.currentRun.RunNumber))
+	if !a.artifactsLoading && len(a.artifacts) > 0 {
+		var total int64
+		for _, artifact := range a.artifacts {
+			total += artifact.SizeInBytes
+		}
+		header = lipgloss.JoinVertical(lipgloss.Left, header,
+			a.styles.HelpDesc.Render(fmt.Sprintf("%d artifacts, %s total", len(a.artifacts), components.FormatByteSize(total))))
+	}
+
+	var content string
+	switch {
+	case a.artifactsLoading:
+		content = a.styles.GetStatusInProgress().Render("Loading artifacts...")
+	case len(a.artifacts) == 0:
+		content = a.renderEmptyList(
+			"📦 このランにはアーティファクトがありません",
+			"💡 actions/upload-artifact でアップロードされたファイルがここに表示されます",
+		)
+	default:
+		// 幅はルーン数で数える(%-*sもルーン数で埋める)
+		nameWidth := len("Name")
+		for _, artifact := range a.artifacts {
+			nameWidth = max(nameWidth, utf8.RuneCountInString(artifact.Name))
+		}
+		nameWidth = min(nameWidth, max(a.width-40, 10))
+
+		lines := []string{a.styles.HelpDesc.Render(fmt.Sprintf("  %-*s %10s  %s", nameWidth, "Name", "Size", "Retention"))}
+		for i, artifact := range a.artifacts {
+			name := artifact.Name
+			if runes := []rune(name); len(runes) > nameWidth {
+				name = string(runes[:nameWidth-3]) + "..."
+			}
+			entry := fmt.Sprintf("%-*s %10s  ", nameWidth, name, components.FormatByteSize(artifact.SizeInBytes))
+
+			marker := "  "
+			style := a.styles.HelpDesc
+			if i == a.artifactIndex {
+				marker = "> "
+				style = a.styles.HelpKey
+			}
+
+			// 期限切れは全体を薄く表示する
+			remaining := time.Until(artifact.ExpiresAt)
+			if artifact.Expired || remaining <= 0 {
+				lines = append(lines, a.styles.StatusSkipped.Render(marker+entry+"Expired"))
+				continue
+			}
+			expires := "Expires: " + formatRetention(remaining)
+			if remaining < 3*24*time.Hour {
+				expires = a.styles.StatusFailure.Render(expires)
+			} else {
+				expires = style.Render(expires)
+			}
+			lines = append(lines, style.Render(marker+entry)+expires)
+		}
+		content = strings.Join(lines, "\n")
+	}
+
+	var status string
+	switch {
+	case a.artifactDownloading != nil:
+		status = a.renderArtifactDownloadProgress()
+	case a.statusMessage != "":
+		status = a.styles.StatusSuccess.Render(a.statusMessage)
+	}
+	help := a.styles.GetHelp().Render("↑/↓: Select • Enter: Download to ~/Downloads • r: Refresh • Esc/←: Back to logs • ?: Help • q: Quit")
+
+	return lipgloss.JoinVertical(lipgloss.Left, header, content, status, help)
+}
+
+// renderArtifactDownloadProgress renders a progress bar of the artifact being downloaded
+func (a *App) renderArtifactDownloadProgress() string {
+	const barWidth = 20
+	written := a.artifactDownloadBytes.Load()
+	size := a.artifactDownloading.SizeInBytes
+
+	// ZIPのサイズはアーティファクトのサイズと一致しないことがあるので100%で止める
+	ratio := 0.0
+	if size > 0 {
+		ratio = min(float64(written)/float64(size), 1)
+	}
+	filled := int(ratio * barWidth)
+	bar := a.styles.GetStatusInProgress().Render(strings.Repeat("█", filled)) + a.styles.GetHelp().Render(strings.Repeat("░", barWidth-filled))
+
+	return fmt.Sprintf("Downloading %s %s %3d%% (%s / %s)", a.artifactDownloading.Name, bar, int(ratio*100),
+		components.FormatByteSize(written), components.FormatByteSize(size))
+}
+
+// formatRetention formats the time left before an artifact expires (e.g. "5d", or "7h" within a day)
+func formatRetention(remaining time.Duration) string {
+	if remaining < 24*time.Hour {
+		return fmt.Sprintf("%dh", max(int(remaining.Hours()), 0))
+	}
+	return fmt.Sprintf("%dd", int(remaining.Hours()/24))
+}
@@ -0,0 +1,106 @@
+package tui
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/ryo246912/gh-actions-dash/internal/models"
+	"github.com/ryo246912/gh-actions-dash/internal/tui/components"
+)
+
+// runAttemptsLoadedMsg carries every attempt of the run shown in the attempts overlay
+type runAttemptsLoadedMsg struct {
+	runID    int64
+	attempts []models.WorkflowRun // latest attempt first
+	jobs     []models.Job         // jobs of every attempt
+	err      error
+}
+
+// openRunAttempts shows the attempt history of the selected run and loads the previous attempts
+func (a *App) openRunAttempts() tea.Cmd {
+	run := a.selectedRun()
+	if run == nil {
+		return nil
+	}
+	if run.RunAttempt <= 1 {
+		return a.flashStatus("This run has not been re-run")
+	}
+	a.showRunAttempts = true
+	a.runAttemptsRun = *run
+	a.runAttempts = nil
+	a.runAttemptJobs = nil
+	a.runAttemptsErr = nil
+	a.runAttemptsLoading = true
+
+	owner, repo, latest := a.owner, a.repo, *run
+	return tea.Cmd(func() tea.Msg {
+		jobs, err := a.client.GetAllAttemptJobs(owner, repo, latest.ID)
+		if err != nil {
+			return runAttemptsLoadedMsg{runID: latest.ID, err: err}
+		}
+
+		attempts := []models.WorkflowRun{latest}
+		for attempt := latest.RunAttempt - 1; attempt >= 1; attempt-- {
+			previous, err := a.client.GetPreviousRunAttempt(owner, repo, latest.ID, attempt)
+			if err != nil {
+				return runAttemptsLoadedMsg{runID: latest.ID, err: err}
+			}
+			attempts = append(attempts, *previous)
+		}
+		return runAttemptsLoadedMsg{runID: latest.ID, attempts: attempts, jobs: jobs}
+	})
+}
+
+// handleRunAttemptsLoaded stores the attempts shown in the attempts overlay
+func (a *App) handleRunAttemptsLoaded(msg runAttemptsLoadedMsg) (tea.Model, tea.Cmd) {
+	// 別のランの履歴を開き直した場合は捨てる
+	if !a.showRunAttempts || a.runAttemptsRun.ID != msg.runID {
+		return a, nil
+	}
+	a.runAttemptsLoading = false
+	a.runAttempts = msg.attempts
+	a.runAttemptJobs = msg.jobs
+	a.runAttemptsErr = msg.err
+	return a, nil
+}
+
+// renderRunAttemptsView renders the overlay listing every attempt of the run with its jobs
+func (a *App) renderRunAttemptsView() string {
+	run := a.runAttemptsRun
+	lines := []string{a.styles.GetTitle().Render(fmt.Sprintf("Run Attempts - %s #%d", run.Name, run.RunNumber)), ""}
+
+	switch {
+	case a.runAttemptsLoading:
+		lines = append(lines, a.styles.HelpDesc.Render("Loading..."))
+	case a.runAttemptsErr != nil:
+		lines = append(lines, a.styles.StatusFailure.Render(fmt.Sprintf("Failed to get run attempts: %v", a.runAttemptsErr)))
+	default:
+		for i, attempt := range a.runAttempts {
+			if i > 0 {
+				lines = append(lines, "")
+			}
+			status := components.GetCIStatus(attempt.Status, attempt.Conclusion)
+			header := a.styles.StatusStyle(status).Render(components.StatusIcon(status)) + " " +
+				a.styles.HelpKey.Render(fmt.Sprintf("Attempt %d", attempt.RunAttempt))
+			if !attempt.RunStartedAt.IsZero() {
+				header += a.styles.HelpDesc.Render("  " + attempt.RunStartedAt.Local().Format("2006-01-02 15:04:05"))
+			}
+			lines = append(lines, header)
+
+			// filter=allのジョブはアテンプト番号で振り分ける
+			for _, job := range a.runAttemptJobs {
+				if job.RunAttempt != attempt.RunAttempt {
+					continue
+				}
+				jobStatus := components.GetCIStatus(job.Status, job.Conclusion)
+				lines = append(lines, "    "+a.styles.StatusStyle(jobStatus).Render(components.StatusIcon(jobStatus))+" "+job.Name)
+			}
+		}
+	}
+
+	lines = append(lines, "", a.styles.HelpDesc.Render("H/Esc/q: Close"))
+
+	overlay := a.styles.ActiveBorder.Padding(1, 2).Render(lipgloss.JoinVertical(lipgloss.Left, lines...))
+	return lipgloss.Place(a.width, a.height, lipgloss.Center, lipgloss.Center, overlay)
+}
@@ -0,0 +1,108 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/ryo246912/gh-actions-dash/internal/logs"
+)
+
+// addLogBookmark bookmarks the log line shown at the top of the log view
+func (a *App) addLogBookmark() tea.Cmd {
+	// ブックマークはラン全体のログの行番号で保存する
+	if a.bookmarks == nil || a.currentRun == nil || a.logs == "" || a.jobLog != nil {
+		return nil
+	}
+
+	line := a.logLineAtRow(a.logOffset) + 1
+	added, err := a.bookmarks.Add(a.currentRun.ID, line)
+	if err != nil {
+		return a.flashStatus(fmt.Sprintf("Failed to save bookmark: %v", err))
+	}
+	if !added {
+		return a.flashStatus(fmt.Sprintf("Line %d is already bookmarked", line))
+	}
+	return a.flashStatus(fmt.Sprintf("Bookmarked line %d", line))
+}
+
+// openBookmarkList opens the bookmark list of the current run
+func (a *App) openBookmarkList() tea.Cmd {
+	if a.bookmarks == nil || a.currentRun == nil || a.jobLog != nil {
+		return nil
+	}
+	if len(a.bookmarks.Lines(a.currentRun.ID)) == 0 {
+		return a.flashStatus("No bookmarks for this run (B to add)")
+	}
+	a.bookmarkMode = true
+	a.bookmarkIndex = 0
+	return nil
+}
+
+// handleBookmarkInput handles the bookmark list (select, jump, delete)
+func (a *App) handleBookmarkInput(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	lines := a.bookmarks.Lines(a.currentRun.ID)
+
+	switch {
+	case key.Matches(msg, a.keyMap.Up):
+		if a.bookmarkIndex > 0 {
+			a.bookmarkIndex--
+		}
+	case key.Matches(msg, a.keyMap.Down):
+		if a.bookmarkIndex < len(lines)-1 {
+			a.bookmarkIndex++
+		}
+	case key.Matches(msg, a.keyMap.Enter):
+		a.bookmarkMode = false
+		if a.bookmarkIndex < len(lines) {
+			a.jumpToLogLine(lines[a.bookmarkIndex] - 1)
+		}
+	case msg.String() == "D":
+		if a.bookmarkIndex >= len(lines) {
+			return a, nil
+		}
+		line := lines[a.bookmarkIndex]
+		if err := a.bookmarks.Remove(a.currentRun.ID, line); err != nil {
+			return a, a.flashStatus(fmt.Sprintf("Failed to delete bookmark: %v", err))
+		}
+		// 最後のブックマークを削除したら閉じる
+		if len(lines) == 1 {
+			a.bookmarkMode = false
+		} else if a.bookmarkIndex >= len(lines)-1 {
+			a.bookmarkIndex = len(lines) - 2
+		}
+		return a, a.flashStatus(fmt.Sprintf("Deleted bookmark at line %d", line))
+	case key.Matches(msg, a.keyMap.Back) || msg.String() == "b":
+		a.bookmarkMode = false
+	}
+	return a, nil
+}
+
+// renderBookmarkList renders the bookmark list of the current run
+func (a *App) renderBookmarkList() string {
+	lines := []string{a.styles.GetTitle().Render("🔖 Bookmarks")}
+
+	logLines := strings.Split(a.logs, "\n")
+	width := len(fmt.Sprintf("%d", len(logLines)))
+	for i, line := range a.bookmarks.Lines(a.currentRun.ID) {
+		text := ""
+		if line-1 < len(logLines) {
+			// 色付きの行はエスケープシーケンスの途中で切らないように先に除去する
+			text = strings.TrimSpace(logs.StripANSI(logLines[line-1]))
+		}
+		if maxText, runes := a.width-width-12, []rune(text); maxText > 3 && len(runes) > maxText {
+			text = string(runes[:maxText-3]) + "..."
+		}
+		entry := fmt.Sprintf("%*d | %s", width, line, text)
+		if i == a.bookmarkIndex {
+			lines = append(lines, a.styles.HelpKey.Render("> "+entry))
+		} else {
+			lines = append(lines, a.styles.HelpDesc.Render("  "+entry))
+		}
+	}
+
+	lines = append(lines, a.styles.HelpDesc.Render("↑/↓: Select • Enter: Jump • D: Delete • Esc: Close"))
+	return lipgloss.JoinVertical(lipgloss.Left, lines...)
+}
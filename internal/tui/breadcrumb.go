@@ -0,0 +1,65 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+)
+
+// breadcrumbHeight is the number of lines reserved for the breadcrumb bar
+const breadcrumbHeight = 1
+
+// breadcrumbPath returns the navigation path for a view, from the root to the view itself
+func (a *App) breadcrumbPath(view ViewState) []string {
+	switch view {
+	case AllRunsView:
+		if label := a.allRunsFilterLabel(); label != "" {
+			return []string{fmt.Sprintf("All Runs (%s)", label)}
+		}
+		return []string{"All Runs"}
+	case WorkflowListView:
+		return []string{"All Runs", "Workflows"}
+	case WorkflowRunsView:
+		path := a.breadcrumbPath(WorkflowListView)
+		if a.currentWorkflow != nil {
+			path = append(path, a.currentWorkflow.Name)
+		}
+		return path
+	case BranchRunsView:
+		return []string{"All Runs", "Branch: " + a.branchFilter}
+	case PRRunsView:
+		return []string{"All Runs", fmt.Sprintf("PR #%d", a.prNumber)}
+	case WorkflowRunLogsView:
+		path := a.breadcrumbPath(a.logsParentView)
+		if a.currentRun != nil {
+			path = append(path, fmt.Sprintf("Run #%d", a.currentRun.RunNumber))
+		}
+		path = append(path, "Logs")
+		if a.jobLog != nil {
+			path = append(path, "Job: "+a.jobLog.Name)
+		}
+		return path
+	case ArtifactsView:
+		return append(a.breadcrumbPath(WorkflowRunLogsView), "Artifacts")
+	case CachesView:
+		return append(a.breadcrumbPath(WorkflowListView), "Caches")
+	case DebugLogView:
+		return append(a.breadcrumbPath(a.debugParentView), "API Debug Log")
+	}
+	return nil
+}
+
+// renderBreadcrumb renders the current navigation path, e.g. "All Runs › my-workflow › Run #42 › Logs"
+func (a *App) renderBreadcrumb() string {
+	path := a.breadcrumbPath(a.viewState)
+	if a.viewingWorkflowFile && a.workflowFilePath != "" {
+		path = append(path, a.workflowFilePath)
+	}
+
+	crumbs := make([]string, len(path))
+	for i, crumb := range path {
+		crumbs[i] = a.styles.GetSubtitle().UnsetPadding().Render(crumb)
+	}
+	separator := a.styles.BreadcrumbSeparator.Render(" › ")
+	// 幅を超えても1行に収める
+	return a.styles.GetSubtitle().MaxWidth(a.width).Render(strings.Join(crumbs, separator))
+}
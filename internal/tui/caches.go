@@ -0,0 +1,195 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/ryo246912/gh-actions-dash/internal/models"
+	"github.com/ryo246912/gh-actions-dash/internal/tui/components"
+)
+
+type cacheUsageLoadedMsg struct {
+	activeBytes int64
+	err         error
+}
+
+type cachesLoadedMsg struct {
+	caches []models.ActionsCacheEntry
+	err    error
+}
+
+type cacheDeletedMsg struct {
+	cache models.ActionsCacheEntry
+	err   error
+}
+
+// loadCacheUsage fetches the Actions cache usage of the repository once per repository
+func (a *App) loadCacheUsage() tea.Cmd {
+	if a.cacheUsageRequested {
+		return nil
+	}
+	a.cacheUsageRequested = true
+	return tea.Cmd(func() tea.Msg {
+		activeBytes, err := a.client.GetActionsCacheUsage(a.owner, a.repo)
+		return cacheUsageLoadedMsg{activeBytes: activeBytes, err: err}
+	})
+}
+
+// handleCacheUsageLoaded stores the cache usage shown in the workflow preview
+func (a *App) handleCacheUsageLoaded(msg cacheUsageLoadedMsg) (tea.Model, tea.Cmd) {
+	// 取得できない場合(権限不足など)はプレビューに表示しないだけにする
+	if msg.err != nil {
+		return a, nil
+	}
+	a.cacheUsage = &components.CacheUsage{ActiveBytes: msg.activeBytes}
+	return a, nil
+}
+
+// openCachesView switches to the Actions cache list of the repository
+func (a *App) openCachesView() (tea.Model, tea.Cmd) {
+	a.viewState = CachesView
+	a.caches = nil
+	a.cacheIndex = 0
+	a.cachesLoading = true
+	a.cacheDeleteConfirm = false
+	return a, a.loadCaches()
+}
+
+// loadCaches fetches the Actions caches of the repository
+func (a *App) loadCaches() tea.Cmd {
+	return tea.Cmd(func() tea.Msg {
+		caches, err := a.client.ListActionsCaches(a.owner, a.repo)
+		return cachesLoadedMsg{caches: caches, err: err}
+	})
+}
+
+// handleCachesLoaded shows the fetched caches
+func (a *App) handleCachesLoaded(msg cachesLoadedMsg) (tea.Model, tea.Cmd) {
+	a.cachesLoading = false
+	if msg.err != nil {
+		return a, a.flashStatus(fmt.Sprintf("Failed to get caches: %v", msg.err))
+	}
+	a.caches = msg.caches
+	a.cacheIndex = min(a.cacheIndex, max(len(a.caches)-1, 0))
+	return a, nil
+}
+
+// handleCachesKey handles the keys of the cache list
+func (a *App) handleCachesKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if a.cacheDeleteConfirm {
+		a.cacheDeleteConfirm = false
+		if msg.String() == "y" {
+			return a, a.deleteSelectedCache()
+		}
+		return a, nil
+	}
+
+	switch {
+	case key.Matches(msg, a.keyMap.Back, a.keyMap.Left):
+		return a.goBack()
+	case key.Matches(msg, a.keyMap.Up):
+		if a.cacheIndex > 0 {
+			a.cacheIndex--
+		}
+	case key.Matches(msg, a.keyMap.Down):
+		if a.cacheIndex < len(a.caches)-1 {
+			a.cacheIndex++
+		}
+	case key.Matches(msg, a.keyMap.Refresh):
+		a.cachesLoading = true
+		return a, a.loadCaches()
+	case msg.String() == "x" && a.cacheIndex < len(a.caches):
+		// 誤削除を防ぐため確認する
+		a.cacheDeleteConfirm = true
+	}
+	return a, nil
+}
+
+// deleteSelectedCache deletes the cache under the cursor
+func (a *App) deleteSelectedCache() tea.Cmd {
+	if a.cacheIndex >= len(a.caches) {
+		return nil
+	}
+	cache := a.caches[a.cacheIndex]
+	return tea.Cmd(func() tea.Msg {
+		err := a.client.DeleteActionsCache(a.owner, a.repo, cache.ID)
+		return cacheDeletedMsg{cache: cache, err: err}
+	})
+}
+
+// handleCacheDeleted removes the deleted cache from the list and reloads the usage
+func (a *App) handleCacheDeleted(msg cacheDeletedMsg) (tea.Model, tea.Cmd) {
+	if msg.err != nil {
+		return a, a.flashStatus(fmt.Sprintf("Failed to delete cache %s: %v", msg.cache.Key, msg.err))
+	}
+
+	for i, cache := range a.caches {
+		if cache.ID == msg.cache.ID {
+			a.caches = append(a.caches[:i], a.caches[i+1:]...)
+			break
+		}
+	}
+	a.cacheIndex = min(a.cacheIndex, max(len(a.caches)-1, 0))
+
+	// 使用量を取り直す
+	a.cacheUsageRequested = false
+	return a, tea.Batch(a.flashStatus("Deleted cache "+msg.cache.Key), a.loadCacheUsage())
+}
+
+// renderCachesView renders the Actions cache list of the repository
+func (a *App) renderCachesView() string {
+	title := fmt.Sprintf("Actions Caches - %s/%s", a.owner, a.repo)
+	if a.cacheUsage != nil {
+		title += fmt.Sprintf(" (%s / %s)", components.FormatByteSize(a.cacheUsage.ActiveBytes), components.FormatByteSize(components.ActionsCacheQuota))
+	}
+	header := a.styles.GetTitle().Render(title)
+
+	var content string
+	switch {
+	case a.cachesLoading:
+		content = a.styles.GetStatusInProgress().Render("Loading caches...")
+	case len(a.caches) == 0:
+		content = a.renderEmptyList(
+			"🗄 このリポジトリにはキャッシュがありません",
+			"💡 actions/cache で保存されたキャッシュがここに表示されます",
+		)
+	default:
+		// 幅はルーン数で数える(%-*sもルーン数で埋める)
+		keyWidth := len("Key")
+		for _, cache := range a.caches {
+			keyWidth = max(keyWidth, utf8.RuneCountInString(cache.Key))
+		}
+		keyWidth = min(keyWidth, max(a.width-60, 10))
+
+		lines := []string{a.styles.HelpDesc.Render(fmt.Sprintf("  %-*s %10s  %-16s  %s", keyWidth, "Key", "Size", "Last used", "Ref"))}
+		for i, cache := range a.caches {
+			cacheKey := cache.Key
+			if runes := []rune(cacheKey); len(runes) > keyWidth {
+				cacheKey = string(runes[:keyWidth-3]) + "..."
+			}
+			entry := fmt.Sprintf("%-*s %10s  %-16s  %s", keyWidth, cacheKey,
+				components.FormatByteSize(cache.SizeInBytes), cache.LastAccessedAt.Local().Format("2006-01-02 15:04"), cache.Ref)
+			if i == a.cacheIndex {
+				lines = append(lines, a.styles.HelpKey.Render("> "+entry))
+			} else {
+				lines = append(lines, a.styles.HelpDesc.Render("  "+entry))
+			}
+		}
+		content = strings.Join(lines, "\n")
+	}
+
+	var status string
+	switch {
+	case a.cacheDeleteConfirm && a.cacheIndex < len(a.caches):
+		status = a.styles.StatusStyle("warning").Render(fmt.Sprintf("Delete cache %s? (y/n)", a.caches[a.cacheIndex].Key))
+	case a.statusMessage != "":
+		status = a.styles.StatusSuccess.Render(a.statusMessage)
+	}
+	help := a.styles.GetHelp().Render("↑/↓: Select • x: Delete • r: Refresh • Esc/←: Back to workflows • ?: Help • q: Quit")
+
+	return lipgloss.JoinVertical(lipgloss.Left, header, content, status, help)
+}
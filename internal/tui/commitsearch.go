@@ -0,0 +1,39 @@
+package tui
+
+import (
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/ryo246912/gh-actions-dash/internal/models"
+)
+
+// matchesCommitSearch reports whether the commit message of run contains the commit search query (case-insensitive).
+// Every run matches while no search is active.
+func (a *App) matchesCommitSearch(run models.WorkflowRun) bool {
+	if a.commitSearchQuery == "" {
+		return true
+	}
+	return strings.Contains(strings.ToLower(run.HeadCommit.Message), strings.ToLower(a.commitSearchQuery))
+}
+
+// setCommitSearch dims the runs whose commit message does not contain query
+func (a *App) setCommitSearch(query string) {
+	a.commitSearchQuery = query
+	a.updateAllRunsList()
+}
+
+// handleCommitSearchInput handles the commit message search input
+func (a *App) handleCommitSearchInput(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyEnter:
+		a.commitSearchInputMode = false
+		a.setCommitSearch(strings.TrimSpace(a.commitSearchBuffer))
+		a.commitSearchBuffer = ""
+	case tea.KeyEsc:
+		a.commitSearchInputMode = false
+		a.commitSearchBuffer = ""
+	default:
+		a.commitSearchBuffer = editInputBuffer(a.commitSearchBuffer, msg)
+	}
+	return a, nil
+}
@@ -0,0 +1,137 @@
+package components
+
+import (
+	"fmt"
+	"strings"
+)
+
+// RunColumn identifies a column of the run list
+type RunColumn int
+
+// Columns of the run list, in their default order
+const (
+	RunColumnName RunColumn = iota
+	RunColumnEvent
+	RunColumnStatus
+	RunColumnBranch
+	RunColumnActor
+	RunColumnSHA
+	RunColumnPR
+	RunColumnDuration
+	RunColumnTime
+)
+
+// DefaultRunColumns lists the columns shown when no columns are configured
+var DefaultRunColumns = []RunColumn{
+	RunColumnName, RunColumnEvent, RunColumnStatus, RunColumnBranch, RunColumnActor, RunColumnSHA, RunColumnPR, RunColumnDuration, RunColumnTime,
+}
+
+// runColumnSpec describes the header and sizing of a run list column
+type runColumnSpec struct {
+	title    string
+	width    int // preferred width
+	minWidth int // width the column may shrink to on narrow terminals
+	flexible bool
+}
+
+var runColumnSpecs = map[RunColumn]runColumnSpec{
+	RunColumnName:     {title: "Name", width: 25, minWidth: 12, flexible: true},
+	RunColumnEvent:    {title: "Ev", width: 2, minWidth: 2},
+	RunColumnStatus:   {title: "Status", width: 12, minWidth: 12},
+	RunColumnBranch:   {title: "Branch", width: 18, minWidth: 8, flexible: true},
+	RunColumnActor:    {title: "Actor", width: 15, minWidth: 8, flexible: true},
+	RunColumnSHA:      {title: "SHA", width: 7, minWidth: 7},
+	RunColumnPR:       {title: "PR", width: 12, minWidth: 5, flexible: true},
+	RunColumnDuration: {title: "Duration", width: 8, minWidth: 8},
+	RunColumnTime:     {title: "Time", width: 11, minWidth: 11},
+}
+
+// columnWidths sizes the columns to fill width. A width of 0 uses the preferred widths.
+func columnWidths(columns []RunColumn, width int) []int {
+	widths := make([]int, len(columns))
+	total := max(len(columns)-1, 0) // 列間のスペース
+	var flexible []int
+	for i, column := range columns {
+		spec := runColumnSpecs[column]
+		widths[i] = spec.width
+		total += spec.width
+		if spec.flexible {
+			flexible = append(flexible, i)
+		}
+	}
+	if width <= 0 || len(flexible) == 0 {
+		return widths
+	}
+
+	// 余った幅は可変列に均等に配分する
+	if extra := width - total; extra > 0 {
+		for n, i := range flexible {
+			widths[i] += extra / len(flexible)
+			if n < extra%len(flexible) {
+				widths[i]++
+			}
+		}
+		return widths
+	}
+
+	// 足りない場合は右側の可変列から順に最小幅まで縮める
+	deficit := total - width
+	for n := len(flexible) - 1; n >= 0 && deficit > 0; n-- {
+		i := flexible[n]
+		shrink := min(widths[i]-runColumnSpecs[columns[i]].minWidth, deficit)
+		widths[i] -= shrink
+		deficit -= shrink
+	}
+	return widths
+}
+
+// fitColumn truncates s to width runes and pads it to exactly width
+func fitColumn(s string, width int) string {
+	runes := []rune(s)
+	if len(runes) > width {
+		if width > 3 {
+			s = string(runes[:width-3]) + "..."
+		} else {
+			s = string(runes[:width])
+		}
+	}
+	return fmt.Sprintf("%-*s", width, s)
+}
+
+// RunListHeader returns the table header matching the rows rendered by the delegate
+func (d *WorkflowRunItemDelegate) RunListHeader() string {
+	columns := d.visibleColumns()
+	widths := columnWidths(columns, d.rowWidth())
+
+	titles := make([]string, len(columns))
+	for i, column := range columns {
+		titles[i] = fitColumn(runColumnSpecs[column].title, widths[i])
+	}
+	header := strings.TrimRight(strings.Join(titles, " "), " ")
+	if d.showCheckboxes {
+		// チェックボックス列の分だけずらす
+		header = "    " + header
+	}
+	return header
+}
+
+// visibleColumns returns the configured columns, or the default columns
+func (d *WorkflowRunItemDelegate) visibleColumns() []RunColumn {
+	if len(d.columns) == 0 {
+		return DefaultRunColumns
+	}
+	return d.columns
+}
+
+// rowWidth returns the width available to the columns of a row
+func (d *WorkflowRunItemDelegate) rowWidth() int {
+	if d.width <= 0 {
+		return 0
+	}
+	// 左右のパディングとチェックボックス列を除く
+	width := d.width - 2
+	if d.showCheckboxes {
+		width -= 4
+	}
+	return max(width, 1)
+}
@@ -0,0 +1,75 @@
+package components
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// matchHighlightStyle is the style used for fuzzy-matched characters
+var matchHighlightStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("226")).Bold(true)
+
+// FuzzyMatch checks whether query is a case-insensitive character subsequence of target.
+// It returns a score (higher is better) and the rune indexes of the matched characters.
+func FuzzyMatch(query, target string) (int, []int, bool) {
+	q := []rune(query)
+	if len(q) == 0 {
+		return 0, nil, true
+	}
+	t := []rune(target)
+
+	var indexes []int
+	score := 0
+	qi := 0
+	prev := -2
+	for ti := 0; ti < len(t) && qi < len(q); ti++ {
+		if unicode.ToLower(t[ti]) != unicode.ToLower(q[qi]) {
+			continue
+		}
+		score++
+		// Consecutive matches score higher
+		if ti == prev+1 {
+			score += 2
+		}
+		// Matches at the start of a word score higher
+		if ti == 0 || isWordSeparator(t[ti-1]) {
+			score += 3
+		}
+		indexes = append(indexes, ti)
+		prev = ti
+		qi++
+	}
+
+	if qi < len(q) {
+		return 0, nil, false
+	}
+	return score, indexes, true
+}
+
+// isWordSeparator reports whether r separates words in names and paths
+func isWordSeparator(r rune) bool {
+	return unicode.IsSpace(r) || strings.ContainsRune("/-_.:(#", r)
+}
+
+// HighlightMatches renders the runes at the given indexes with the match highlight style
+func HighlightMatches(s string, indexes []int) string {
+	if len(indexes) == 0 {
+		return s
+	}
+
+	matched := make(map[int]bool, len(indexes))
+	for _, i := range indexes {
+		matched[i] = true
+	}
+
+	var b strings.Builder
+	for i, r := range []rune(s) {
+		if matched[i] {
+			b.WriteString(matchHighlightStyle.Render(string(r)))
+		} else {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
@@ -14,7 +14,10 @@ import (
 
 // WorkflowItem represents a workflow in the list
 type WorkflowItem struct {
-	Workflow models.Workflow
+	Workflow       models.Workflow
+	MatchedIndexes []int    // rune indexes of the name matched by the filter
+	History        []string // conclusions of the recent completed runs, oldest first
+	HistoryLoaded  bool     // false while the recent runs are not loaded yet
 }
 
 // FilterValue returns the value to filter on
@@ -61,6 +64,103 @@ func GetCIStatus(status, conclusion string) string {
 	}
 }
 
+// CountActiveRuns returns the number of in-progress and queued runs
+func CountActiveRuns(runs []models.WorkflowRun) (running, queued int) {
+	for _, run := range runs {
+		switch run.Status {
+		case "in_progress":
+			running++
+		case "queued":
+			queued++
+		}
+	}
+	return running, queued
+}
+
+// FormatActiveRuns formats active run counts as "(3 running, 2 queued)", or "" if there are none
+func FormatActiveRuns(running, queued int) string {
+	if running == 0 && queued == 0 {
+		return ""
+	}
+	return fmt.Sprintf("(%d running, %d queued)", running, queued)
+}
+
+// parseMatrixSuffix splits a matrix job name such as "test (ubuntu-latest, 18)"
+// into its base name and matrix parameters. params is empty for non-matrix jobs.
+func parseMatrixSuffix(name string) (baseName string, params string) {
+	trimmed := strings.TrimSpace(name)
+	if !strings.HasSuffix(trimmed, ")") {
+		return name, ""
+	}
+	open := strings.LastIndex(trimmed, " (")
+	if open <= 0 {
+		return name, ""
+	}
+	return trimmed[:open], trimmed[open+2 : len(trimmed)-1]
+}
+
+// JobGroup is a set of jobs sharing the same base name (the legs of a matrix job)
+type JobGroup struct {
+	Name    string
+	Indexes []int    // indexes of the jobs in the original slice
+	Params  []string // matrix parameters of each job, parallel to Indexes
+}
+
+// IsMatrix reports whether the group consists of matrix legs
+func (g JobGroup) IsMatrix() bool {
+	return len(g.Indexes) > 1 || (len(g.Params) == 1 && g.Params[0] != "")
+}
+
+// GroupMatrixJobs groups jobs by their base name, keeping the order of first appearance
+func GroupMatrixJobs(jobs []models.Job) []JobGroup {
+	var groups []JobGroup
+	groupIndex := make(map[string]int)
+	for i, job := range jobs {
+		base, params := parseMatrixSuffix(job.Name)
+		gi, exists := groupIndex[base]
+		if !exists {
+			gi = len(groups)
+			groupIndex[base] = gi
+			groups = append(groups, JobGroup{Name: base})
+		}
+		groups[gi].Indexes = append(groups[gi].Indexes, i)
+		groups[gi].Params = append(groups[gi].Params, params)
+	}
+	return groups
+}
+
+// statusSeverity ranks CI statuses so that the worst one can be picked
+func statusSeverity(status string) int {
+	switch status {
+	case "failure", "timed_out", "startup_failure":
+		return 6
+	case "cancelled":
+		return 5
+	case "action_required", "waiting":
+		return 4
+	case "in_progress":
+		return 3
+	case "queued", "pending", "requested":
+		return 2
+	case "success", "neutral":
+		return 1
+	default: // skipped
+		return 0
+	}
+}
+
+// WorstJobStatus returns the worst CI status across the given jobs of a group
+func WorstJobStatus(jobs []models.Job, group JobGroup) string {
+	worst := ""
+	for _, i := range group.Indexes {
+		status := GetCIStatus(jobs[i].Status, jobs[i].Conclusion)
+		if worst == "" || statusSeverity(status) > statusSeverity(worst) {
+			worst = status
+		}
+	}
+	return worst
+}
+
 // WorkflowItemDelegate handles rendering of workflow items
 type WorkflowItemDelegate struct {
 	styles Styles
@@ -115,8 +215,8 @@ func (d *WorkflowItemDelegate) Render(w io.Writer, m list.Model, index int, list
 	// Build the display string
 	status := statusStyle.Render(fmt.Sprintf("%s %s", statusIcon, workflow.State))
 
-	// Single line: status, name, and filename
-	line := fmt.Sprintf("%s %s • %s", status, name, filename)
+	// Single line: status, history, name, and filename
+	line := fmt.Sprintf("%s %s %s • %s", status, d.renderHistory(item), HighlightMatches(name, item.MatchedIndexes), filename)
 
 	// Apply selection styling
 	if index == m.Index() {
@@ -129,9 +229,34 @@ func (d *WorkflowItemDelegate) Render(w io.Writer, m list.Model, index int, list
 	_, _ = fmt.Fprint(w, line)
 }
 
+// renderHistory renders the recent conclusions of a workflow as a fixed-width sparkline
+func (d *WorkflowItemDelegate) renderHistory(item WorkflowItem) string {
+	if !item.HistoryLoaded {
+		return d.styles.GetHelp().Render(fmt.Sprintf("%-*s", WorkflowHistoryLength, "----"))
+	}
+
+	var b strings.Builder
+	for _, conclusion := range item.History {
+		switch conclusion {
+		case "success":
+			b.WriteString(d.styles.StatusStyle("success").Render("█"))
+		case "cancelled":
+			b.WriteString(d.styles.StatusStyle("failure").Render("▂"))
+		default:
+			b.WriteString(d.styles.StatusStyle("failure").Render("▄"))
+		}
+	}
+	// 履歴が少ないワークフローも名前の位置を揃える
+	b.WriteString(strings.Repeat(" ", WorkflowHistoryLength-len(item.History)))
+	return b.String()
+}
+
 // WorkflowRunItem represents a workflow run in the list
 type WorkflowRunItem struct {
-	Run models.WorkflowRun
+	Run            models.WorkflowRun
+	MatchedIndexes []int // rune indexes of the name matched by the filter
+	Checked        bool  // selected for a batch action
+	Dimmed         bool  // not matched by the commit message search
 }
 
 // FilterValue returns the value to filter on
@@ -141,22 +266,109 @@ func (w WorkflowRunItem) FilterValue() string {
 
 // WorkflowRunItemDelegate handles rendering of workflow run items
 type WorkflowRunItemDelegate struct {
-	styles Styles
+	styles         Styles
+	absoluteTime   bool
+	showCheckboxes bool
+	blinkOn        bool
+	density        int
+	columns        []RunColumn
+	width          int
+	staleThreshold time.Duration
+}
+
+// DefaultStaleRunThreshold is how long an in-progress run may go without updates before it is flagged as stuck
+const DefaultStaleRunThreshold = time.Hour
+
+// isStaleRun reports whether an in-progress run has not been updated for longer than threshold,
+// which usually means its runner is stuck
+func isStaleRun(run models.WorkflowRun, threshold time.Duration) bool {
+	return run.Status == "in_progress" && !run.UpdatedAt.IsZero() && time.Since(run.UpdatedAt) > threshold
+}
+
+// formatStaleThreshold formats the stale threshold without zero units, e.g. "1h" or "1h30m"
+func formatStaleThreshold(threshold time.Duration) string {
+	threshold = threshold.Round(time.Minute)
+	hours, minutes := int(threshold.Hours()), int(threshold.Minutes())%60
+	switch {
+	case hours == 0:
+		return fmt.Sprintf("%dm", minutes)
+	case minutes == 0:
+		return fmt.Sprintf("%dh", hours)
+	default:
+		return fmt.Sprintf("%dh%dm", hours, minutes)
+	}
 }
 
+// List densities of WorkflowRunItemDelegate
+const (
+	DensityCompact = iota
+	DensityComfortable
+	DensitySpacious
+)
+
 // NewWorkflowRunItemDelegate creates a new workflow run item delegate
 func NewWorkflowRunItemDelegate(styles Styles) *WorkflowRunItemDelegate {
 	return &WorkflowRunItemDelegate{styles: styles}
 }
 
+// SetAbsoluteTime switches between absolute and relative timestamps
+func (d *WorkflowRunItemDelegate) SetAbsoluteTime(enabled bool) {
+	d.absoluteTime = enabled
+}
+
+// SetShowCheckboxes shows or hides the batch selection checkbox column
+func (d *WorkflowRunItemDelegate) SetShowCheckboxes(enabled bool) {
+	d.showCheckboxes = enabled
+}
+
+// SetBlink sets whether the blinking marker of in-progress runs is currently lit (unlit markers are dimmed)
+func (d *WorkflowRunItemDelegate) SetBlink(on bool) {
+	d.blinkOn = on
+}
+
+// Blink reports whether the blinking marker of in-progress runs is currently lit
+func (d *WorkflowRunItemDelegate) Blink() bool {
+	return d.blinkOn
+}
+
+// SetDensity sets the list density (DensityCompact, DensityComfortable or DensitySpacious)
+func (d *WorkflowRunItemDelegate) SetDensity(density int) {
+	d.density = density
+}
+
+// SetColumns sets the columns shown in each row (nil shows DefaultRunColumns)
+func (d *WorkflowRunItemDelegate) SetColumns(columns []RunColumn) {
+	d.columns = columns
+}
+
+// SetStaleThreshold sets how long an in-progress run may go without updates before it is flagged as stuck
+func (d *WorkflowRunItemDelegate) SetStaleThreshold(threshold time.Duration) {
+	d.staleThreshold = threshold
+}
+
+// SetWidth sets the list width the columns are sized to fill
+func (d *WorkflowRunItemDelegate) SetWidth(width int) {
+	d.width = width
+}
+
 // Height returns the height of the item
 func (d *WorkflowRunItemDelegate) Height() int {
+	if d.density == DensitySpacious {
+		return 2
+	}
 	return 1
 }
 
 // Spacing returns the spacing between items
 func (d *WorkflowRunItemDelegate) Spacing() int {
-	return 1
+	switch d.density {
+	case DensityCompact:
+		return 0
+	case DensitySpacious:
+		return 2
+	default:
+		return 1
+	}
 }
 
 // Update handles updates to the item
@@ -173,9 +385,6 @@ func (d *WorkflowRunItemDelegate) Render(w io.Writer, m list.Model, index int, l
 
 	run := item.Run
 
-	// Get detailed CI status
-	ciStatus := GetCIStatus(run.Status, run.Conclusion)
-
 	// Status icon and color based on conclusion if completed, otherwise status
 	var statusIcon string
 	var statusStyle lipgloss.Style
@@ -187,82 +396,185 @@ func (d *WorkflowRunItemDelegate) Render(w io.Writer, m list.Model, index int, l
 		statusIcon = StatusIcon(run.Status)
 		statusStyle = d.styles.StatusStyle(run.Status)
 	}
+	// 実行中のランは点滅するドットで目立たせる
+	// アイコンを消すと列の内容が揺れて見えるので、消灯時は色を薄くする
+	if run.Status == "in_progress" {
+		statusIcon = "●"
+		if !d.blinkOn {
+			statusStyle = statusStyle.Faint(true)
+		}
+	}
+	// 長時間更新のない実行中のランはランナーが止まっている可能性がある
+	if d.staleThreshold > 0 && isStaleRun(run, d.staleThreshold) {
+		statusIcon = "⚠"
+		statusStyle = d.styles.StatusStyle("warning")
+	}
 
-	// Workflow name with run number (truncated)
-	name := fmt.Sprintf("%s(#%d)", run.Name, run.RunNumber)
-	if len(name) > 25 {
-		name = name[:22] + "..."
+	columns := d.visibleColumns()
+	widths := columnWidths(columns, d.rowWidth())
+
+	plain := make([]string, len(columns))
+	styled := make([]string, len(columns))
+	for i, column := range columns {
+		cell := fitColumn(d.columnText(column, item, statusIcon), widths[i])
+		switch column {
+		case RunColumnEvent:
+			// 絵文字は2セル幅なのでルーン数ではなく表示幅で揃える
+			icon := EventIcon(run.Event)
+			cell = icon + strings.Repeat(" ", max(widths[i]-lipgloss.Width(icon), 0))
+		case RunColumnName:
+			// Highlight filter matches in the name column
+			cell = HighlightMatches(cell, item.MatchedIndexes)
+		}
+		plain[i] = cell
+		styled[i] = cell
+		switch column {
+		case RunColumnStatus:
+			styled[i] = statusStyle.Render(cell)
+		case RunColumnSHA:
+			styled[i] = d.styles.GetSubtitle().UnsetPadding().Render(cell)
+		}
 	}
-	name = fmt.Sprintf("%-25s", name)
 
-	// Status column (without styling yet)
-	statusText := fmt.Sprintf("%s %-10s", statusIcon, ciStatus)
+	// Checkbox column for batch selection
+	checkbox := ""
+	if d.showCheckboxes {
+		if item.Checked {
+			checkbox = "[x] "
+		} else {
+			checkbox = "[ ] "
+		}
+	}
 
-	// Branch name (truncated)
-	branch := run.HeadBranch
-	if len(branch) > 18 {
-		branch = branch[:15] + "..."
+	// Apply selection styling to the entire line, then apply status color to just the status part
+	var line string
+	if index == m.Index() {
+		line = d.styles.SelectedItem().Render(checkbox + strings.Join(plain, " "))
+	} else if item.Dimmed {
+		// コミットメッセージ検索に一致しない行は目立たなくする
+		line = d.styles.ListItem().Render(d.styles.StatusStyle("skipped").Render(checkbox + strings.Join(plain, " ")))
+	} else {
+		// For non-selected items, apply status color to the status part
+		line = d.styles.ListItem().Render(checkbox + strings.Join(styled, " "))
 	}
-	branch = fmt.Sprintf("%-18s", branch)
 
-	// Actor name (truncated)
-	actor := run.Actor.Login
-	if len(actor) > 15 {
-		actor = actor[:12] + "..."
+	// Spacious mode shows the commit message on a second line
+	if d.density == DensitySpacious {
+		message, _, _ := strings.Cut(run.HeadCommit.Message, "\n")
+		// マルチバイト文字の途中で切らないようにルーン単位で切り詰める
+		if runes := []rune(message); len(runes) > 60 {
+			message = string(runes[:57]) + "..."
+		}
+		line += "\n" + d.styles.ListItem().Render(d.styles.GetHelp().UnsetPadding().Render("  "+message))
 	}
-	actor = fmt.Sprintf("%-15s", actor)
 
-	// PR information (truncated)
-	prInfo := ""
-	if len(run.PullRequests) > 0 {
+	_, _ = fmt.Fprint(w, line)
+}
+
+// columnText returns the unpadded text of a run list column
+func (d *WorkflowRunItemDelegate) columnText(column RunColumn, item WorkflowRunItem, statusIcon string) string {
+	run := item.Run
+	switch column {
+	case RunColumnName:
+		// Workflow name with run number
+		name := fmt.Sprintf("%s(#%d)", run.Name, run.RunNumber)
+		// 再実行された場合は試行回数も表示する
+		if run.RunAttempt > 1 {
+			name += fmt.Sprintf(" (attempt %d)", run.RunAttempt)
+		}
+		return name
+	case RunColumnEvent:
+		return EventIcon(run.Event)
+	case RunColumnStatus:
+		return statusIcon + " " + GetCIStatus(run.Status, run.Conclusion)
+	case RunColumnBranch:
+		return run.HeadBranch
+	case RunColumnActor:
+		// 再実行した人がいればそちらを表示する
+		if run.TriggeringActor.Login != "" {
+			return run.TriggeringActor.Login
+		}
+		return run.Actor.Login
+	case RunColumnSHA:
+		if run.HeadSha == "" {
+			return "-"
+		}
+		return ShortSHA(run.HeadSha)
+	case RunColumnPR:
+		// PR information
+		if len(run.PullRequests) == 0 {
+			return "-"
+		}
 		pr := run.PullRequests[0]
-		prInfo = fmt.Sprintf("#%d", pr.Number)
-		if len(pr.Title) > 8 {
-			prInfo = fmt.Sprintf("#%d:%s...", pr.Number, pr.Title[:4])
-		} else if pr.Title != "" {
-			prInfo = fmt.Sprintf("#%d:%s", pr.Number, pr.Title)
+		if pr.Title == "" {
+			return fmt.Sprintf("#%d", pr.Number)
 		}
-	}
-	if prInfo == "" {
-		prInfo = "-"
-	}
-	prInfo = fmt.Sprintf("%-12s", prInfo)
-
-	// Duration
-	durationStr := ""
-	if run.Status == "completed" && !run.RunStartedAt.IsZero() && !run.UpdatedAt.IsZero() {
-		duration := run.UpdatedAt.Sub(run.RunStartedAt)
-		if duration > 0 {
-			if duration < time.Minute {
-				durationStr = fmt.Sprintf("%.0fs", duration.Seconds())
-			} else if duration < time.Hour {
-				durationStr = fmt.Sprintf("%.0fm", duration.Minutes())
-			} else {
-				durationStr = fmt.Sprintf("%.1fh", duration.Hours())
+		return fmt.Sprintf("#%d:%s", pr.Number, pr.Title)
+	case RunColumnDuration:
+		if run.Status == "completed" && !run.RunStartedAt.IsZero() && !run.UpdatedAt.IsZero() {
+			duration := run.UpdatedAt.Sub(run.RunStartedAt)
+			switch {
+			case duration <= 0:
+			case duration < time.Minute:
+				return fmt.Sprintf("%.0fs", duration.Seconds())
+			case duration < time.Hour:
+				return fmt.Sprintf("%.0fm", duration.Minutes())
+			default:
+				return fmt.Sprintf("%.1fh", duration.Hours())
 			}
 		}
+		return "-"
+	case RunColumnTime:
+		if d.absoluteTime {
+			return run.CreatedAt.Format("01-02 15:04")
+		}
+		return relativeTime(run.CreatedAt)
 	}
-	if durationStr == "" {
-		durationStr = "-"
-	}
-	durationStr = fmt.Sprintf("%-6s", durationStr)
+	return ""
+}
 
-	// Time formatting
-	timeStr := run.CreatedAt.Format("01-02 15:04")
+// eventIcons maps the events that trigger a run to a compact icon
+var eventIcons = map[string]string{
+	"push":              "🔀",
+	"merge_group":       "🔀",
+	"schedule":          "⏰",
+	"workflow_dispatch": "▶",
+	"release":           "🔖",
+	"pull_request":      "PR",
+}
 
-	// Build table row
-	line := fmt.Sprintf("%s %s %s %s %s %s %s",
-		name, statusText, branch, actor, prInfo, durationStr, timeStr)
+// EventIcon returns the icon of the event that triggered a run, or an empty string for other events
+func EventIcon(event string) string {
+	return eventIcons[event]
+}
 
-	// Apply selection styling to the entire line, then apply status color to just the status part
-	if index == m.Index() {
-		line = d.styles.SelectedItem().Render(line)
-	} else {
-		// For non-selected items, apply status color to the status part
-		parts := []string{name, statusStyle.Render(statusText), branch, actor, prInfo, durationStr, timeStr}
-		line = strings.Join(parts, " ")
-		line = d.styles.ListItem().Render(line)
+// ShortSHA returns the abbreviated 7-character form of a commit SHA
+func ShortSHA(sha string) string {
+	if len(sha) > 7 {
+		return sha[:7]
 	}
+	return sha
+}
 
-	_, _ = fmt.Fprint(w, line)
+// relativeTime formats a time relative to now (e.g. "5m ago", "2h ago")
+func relativeTime(t time.Time) string {
+	if t.IsZero() {
+		return "-"
+	}
+
+	elapsed := time.Since(t)
+	switch {
+	case elapsed < time.Minute:
+		return "just now"
+	case elapsed < time.Hour:
+		return fmt.Sprintf("%dm ago", int(elapsed.Minutes()))
+	case elapsed < 24*time.Hour:
+		return fmt.Sprintf("%dh ago", int(elapsed.Hours()))
+	case elapsed < 30*24*time.Hour:
+		return fmt.Sprintf("%dd ago", int(elapsed.Hours()/24))
+	case elapsed < 365*24*time.Hour:
+		return fmt.Sprintf("%dmo ago", int(elapsed.Hours()/(24*30)))
+	default:
+		return fmt.Sprintf("%dy ago", int(elapsed.Hours()/(24*365)))
+	}
 }
@@ -2,17 +2,36 @@ package components
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/ryo246912/gh-actions-dash/internal/models"
 )
 
+// slowQueueThreshold is the queue time above which a job's queued time is highlighted
+const slowQueueThreshold = 2 * time.Minute
+
 // PreviewPanel represents the preview panel for workflow run details
 type PreviewPanel struct {
 	styles Styles
 	width  int
 	height int
+	// Spinner frame shown instead of the jobs while they are loading ("" when not loading)
+	jobsSpinner string
+	// In-progress runs not updated for longer than this are flagged as possibly stuck
+	staleThreshold time.Duration
+}
+
+// SetStaleThreshold sets how long an in-progress run may go without updates before it is flagged as possibly stuck
+func (p *PreviewPanel) SetStaleThreshold(threshold time.Duration) {
+	p.staleThreshold = threshold
+}
+
+// SetJobsSpinner sets the spinner frame shown instead of stale jobs while the jobs are loading.
+// An empty frame shows the jobs.
+func (p *PreviewPanel) SetJobsSpinner(frame string) {
+	p.jobsSpinner = frame
 }
 
 // NewPreviewPanel creates a new preview panel
@@ -38,25 +57,76 @@ func (p *PreviewPanel) RenderWorkflowRunPreview(run *models.WorkflowRun, jobs []
 
 	// Header
 	content.WriteString(p.styles.GetTitle().Render(fmt.Sprintf("Run #%d", run.RunNumber)))
-	content.WriteString("\n\n")
+	content.WriteString("\n")
+	if run.RunAttempt > 1 {
+		content.WriteString(p.styles.GetSubtitle().Render("Attempt: "))
+		content.WriteString(fmt.Sprintf("%d", run.RunAttempt))
+		content.WriteString("\n")
+	}
+	content.WriteString("\n")
 
 	// Run info
 	content.WriteString(p.styles.GetSubtitle().Render("Branch: "))
 	content.WriteString(run.HeadBranch)
 	content.WriteString("\n")
 
+	if run.HeadCommit.Message != "" {
+		content.WriteString(p.styles.GetSubtitle().Render("Commit: "))
+		content.WriteString(commitSummary(run.HeadCommit.Message, p.width-12))
+		content.WriteString("\n")
+	}
+
+	if run.HeadSha != "" {
+		content.WriteString(p.styles.GetSubtitle().Render("SHA: "))
+		content.WriteString(run.HeadSha)
+		content.WriteString("\n")
+	}
+
 	content.WriteString(p.styles.GetSubtitle().Render("Event: "))
+	if icon := EventIcon(run.Event); icon != "" {
+		content.WriteString(icon + " ")
+	}
 	content.WriteString(run.Event)
 	content.WriteString("\n")
 
+	if run.Actor.Login != "" {
+		content.WriteString(p.styles.GetSubtitle().Render("Actor: "))
+		content.WriteString(run.Actor.Login)
+		if run.TriggeringActor.Login != "" && run.TriggeringActor.Login != run.Actor.Login {
+			content.WriteString(fmt.Sprintf(" (triggered by %s)", run.TriggeringActor.Login))
+		}
+		content.WriteString("\n")
+	}
+
 	content.WriteString(p.styles.GetSubtitle().Render("Started: "))
 	content.WriteString(run.RunStartedAt.Format("2006-01-02 15:04:05"))
-	content.WriteString("\n\n")
+	content.WriteString("\n")
+	if p.staleThreshold > 0 && isStaleRun(*run, p.staleThreshold) {
+		content.WriteString(p.styles.StatusStyle("warning").Render(fmt.Sprintf("⚠ Possibly stuck (last update > %s ago)", formatStaleThreshold(p.staleThreshold))))
+		content.WriteString("\n")
+	}
+	content.WriteString("\n")
+
+	// Billable time per OS (not available for every repository)
+	if run.Usage != nil && run.Usage.TotalMs() > 0 {
+		content.WriteString(p.renderRunUsage(run.Usage))
+		content.WriteString("\n")
+	}
+
+	// Deployments waiting for approval
+	if len(run.PendingDeployments) > 0 {
+		content.WriteString(p.renderPendingDeployments(run.PendingDeployments))
+		content.WriteString("\n")
+	}
 
 	// Jobs and steps
-	if len(jobs) == 0 {
+	switch {
+	case p.jobsSpinner != "":
+		// 選択を移動した直後は前のランのジョブを表示しない
+		content.WriteString(p.styles.GetStatusInProgress().Render(p.jobsSpinner + " Loading jobs..."))
+	case len(jobs) == 0:
 		content.WriteString(p.styles.GetStatusInProgress().Render("Loading jobs..."))
-	} else {
+	default:
 		content.WriteString(p.styles.GetTitle().Render("Jobs & Steps"))
 		content.WriteString("\n\n")
 
@@ -77,6 +147,77 @@ func (p *PreviewPanel) RenderWorkflowRunPreview(run *models.WorkflowRun, jobs []
 	return p.renderEmpty()
 }
 
+// renderRunUsage renders the billable minutes and jobs of a run per OS as a table
+func (p *PreviewPanel) renderRunUsage(usage *models.WorkflowRunUsage) string {
+	var content strings.Builder
+	content.WriteString(p.styles.GetSubtitle().Render("Billing:"))
+	content.WriteString("\n")
+	content.WriteString(p.styles.GetHelp().Render(fmt.Sprintf("  %-8s %7s %5s", "OS", "Minutes", "Jobs")))
+	content.WriteString("\n")
+
+	platforms := make([]string, 0, len(usage.Billable))
+	for platform, billable := range usage.Billable {
+		if billable.TotalMs > 0 {
+			platforms = append(platforms, platform)
+		}
+	}
+	sort.Strings(platforms)
+	for _, platform := range platforms {
+		billable := usage.Billable[platform]
+		// 課金は1分単位で切り上げられる
+		minutes := (time.Duration(billable.TotalMs)*time.Millisecond + time.Minute - 1) / time.Minute
+		content.WriteString(fmt.Sprintf("  %-8s %7d %5d\n", platform, minutes, billable.Jobs))
+	}
+	return content.String()
+}
+
+// commitSummary returns the first line of a commit message truncated to maxWidth characters.
+// A "…" suffix is added when the message is truncated or has more lines.
+func commitSummary(message string, maxWidth int) string {
+	firstLine, rest, _ := strings.Cut(strings.TrimSpace(message), "\n")
+	firstLine = strings.TrimSpace(firstLine)
+	truncated := strings.TrimSpace(rest) != ""
+
+	runes := []rune(firstLine)
+	if maxWidth > 0 && len(runes) > maxWidth {
+		runes = runes[:maxWidth-1]
+		truncated = true
+	}
+
+	if truncated {
+		return string(runes) + "…"
+	}
+	return string(runes)
+}
+
+// renderPendingDeployments renders the environments waiting for approval and their reviewers
+func (p *PreviewPanel) renderPendingDeployments(deployments []models.PendingDeployment) string {
+	var content strings.Builder
+
+	content.WriteString(p.styles.StatusStyle("pending").Render("⏳ Awaiting Approval"))
+	content.WriteString("\n")
+
+	for _, deployment := range deployments {
+		content.WriteString(fmt.Sprintf("  %s", deployment.Environment.Name))
+		if deployment.WaitTimer > 0 {
+			content.WriteString(p.styles.GetHelp().UnsetPadding().Render(fmt.Sprintf(" (wait %dm)", deployment.WaitTimer)))
+		}
+		content.WriteString("\n")
+
+		var reviewers []string
+		for _, reviewer := range deployment.Reviewers {
+			reviewers = append(reviewers, reviewer.DisplayName())
+		}
+		if len(reviewers) > 0 {
+			content.WriteString(p.styles.GetSubtitle().Render("  Reviewers: "))
+			content.WriteString(strings.Join(reviewers, ", "))
+			content.WriteString("\n")
+		}
+	}
+
+	return content.String()
+}
+
 // renderJobWithSteps renders a job with its steps
 func (p *PreviewPanel) renderJobWithSteps(job models.Job) string {
 	var content strings.Builder
@@ -103,8 +244,30 @@ func (p *PreviewPanel) renderJobWithSteps(job models.Job) string {
 	}
 
 	content.WriteString(statusStyle.Render(fmt.Sprintf("%s %s", statusIcon, jobName)))
+	// 再実行されたジョブは試行回数も表示する
+	if job.RunAttempt > 1 {
+		content.WriteString(p.styles.GetHelp().UnsetPadding().Render(fmt.Sprintf(" (attempt %d)", job.RunAttempt)))
+	}
 	content.WriteString("\n")
 
+	// Runner labels
+	if len(job.Labels) > 0 {
+		content.WriteString(p.renderRunnerLabels(job.Labels))
+		content.WriteString("\n")
+	}
+
+	// Time spent waiting for a runner
+	if !job.CreatedAt.IsZero() && !job.StartedAt.IsZero() {
+		queued := job.StartedAt.Sub(job.CreatedAt)
+		queuedStyle := p.styles.GetHelp()
+		if queued > slowQueueThreshold {
+			// ランナー不足の可能性があるので目立たせる
+			queuedStyle = queuedStyle.Foreground(p.styles.StatusStyle("pending").GetForeground())
+		}
+		content.WriteString(queuedStyle.Render(fmt.Sprintf("  Queued: %v", queued.Round(time.Second))))
+		content.WriteString("\n")
+	}
+
 	// Duration if completed
 	if !job.StartedAt.IsZero() && !job.CompletedAt.IsZero() {
 		duration := job.CompletedAt.Sub(job.StartedAt)
@@ -118,11 +281,75 @@ func (p *PreviewPanel) renderJobWithSteps(job models.Job) string {
 		for _, step := range job.Steps {
 			content.WriteString(p.renderStep(step))
 		}
+
+		// Step timing bar chart
+		if chart := p.renderStepTimingChart(job.Steps); chart != "" {
+			content.WriteString("\n")
+			content.WriteString(chart)
+		}
 	}
 
 	return content.String()
 }
 
+// renderStepTimingChart renders a horizontal bar per step proportional to its duration
+func (p *PreviewPanel) renderStepTimingChart(steps []models.Step) string {
+	var slowest time.Duration
+	for _, step := range steps {
+		if step.StartedAt.IsZero() || step.CompletedAt.IsZero() {
+			continue
+		}
+		if d := step.CompletedAt.Sub(step.StartedAt); d > slowest {
+			slowest = d
+		}
+	}
+	if slowest <= 0 {
+		return ""
+	}
+
+	maxBarWidth := p.width - 20
+	if maxBarWidth < 1 {
+		maxBarWidth = 1
+	}
+
+	var content strings.Builder
+	for _, step := range steps {
+		// Skip steps that have not finished yet
+		if step.StartedAt.IsZero() || step.CompletedAt.IsZero() {
+			continue
+		}
+
+		duration := step.CompletedAt.Sub(step.StartedAt)
+		barWidth := int(float64(maxBarWidth) * float64(duration) / float64(slowest))
+		if barWidth < 1 {
+			barWidth = 1
+		}
+
+		stepStatus := GetCIStatus(step.Status, step.Conclusion)
+		bar := p.styles.StatusStyle(stepStatus).Render(strings.Repeat("█", barWidth))
+		content.WriteString(fmt.Sprintf("  %2d %s %v\n", step.Number, bar, duration.Round(time.Second)))
+	}
+
+	return content.String()
+}
+
+// renderRunnerLabels renders the runner labels of a job, highlighting self-hosted labels
+func (p *PreviewPanel) renderRunnerLabels(labels []string) string {
+	plain := p.styles.GetHelp().Padding(0)
+	selfHosted := p.styles.StatusStyle("pending")
+
+	rendered := make([]string, len(labels))
+	for i, label := range labels {
+		// セルフホストランナーはキャパシティ不足の原因になりやすいので目立たせる
+		if strings.HasPrefix(label, "self-hosted") {
+			rendered[i] = selfHosted.Render(label)
+		} else {
+			rendered[i] = plain.Render(label)
+		}
+	}
+	return plain.Render("  Runner: ") + strings.Join(rendered, plain.Render(", "))
+}
+
 // renderStep renders a single step
 func (p *PreviewPanel) renderStep(step models.Step) string {
 	stepStatus := step.Status
@@ -150,8 +377,165 @@ func (p *PreviewPanel) renderStep(step models.Step) string {
 		stepName)
 }
 
-// RenderWorkflowPreview renders the workflow preview with basic information
-func (p *PreviewPanel) RenderWorkflowPreview(workflow *models.Workflow) string {
+// sparklineBlocks are the block characters used by sparklines, from lowest to highest
+var sparklineBlocks = []rune("▁▂▃▄▅▆▇█")
+
+// WorkflowStats represents aggregated statistics of recent workflow runs
+type WorkflowStats struct {
+	models.WorkflowStats
+	Completed int
+	Running   int
+	Queued    int
+	// Durations of the most recent completed runs, oldest first
+	Durations []time.Duration
+	// Conclusions of the most recent completed runs, oldest first (at most WorkflowHistoryLength)
+	Conclusions []string
+}
+
+// WorkflowHistoryLength is the number of recent conclusions shown in the workflow list
+const WorkflowHistoryLength = 10
+
+// NewWorkflowStats computes statistics from workflow runs ordered from newest to oldest
+func NewWorkflowStats(runs []models.WorkflowRun) *WorkflowStats {
+	stats := &WorkflowStats{WorkflowStats: models.ComputeStats(runs)}
+	stats.Running, stats.Queued = CountActiveRuns(runs)
+	for i := len(runs) - 1; i >= 0; i-- {
+		run := runs[i]
+		if run.Status != "completed" {
+			continue
+		}
+		stats.Completed++
+		stats.Conclusions = append(stats.Conclusions, run.Conclusion)
+		if !run.RunStartedAt.IsZero() && run.UpdatedAt.After(run.RunStartedAt) {
+			stats.Durations = append(stats.Durations, run.UpdatedAt.Sub(run.RunStartedAt))
+		}
+	}
+	if len(stats.Conclusions) > WorkflowHistoryLength {
+		stats.Conclusions = stats.Conclusions[len(stats.Conclusions)-WorkflowHistoryLength:]
+	}
+	return stats
+}
+
+// secondsDuration formats a number of seconds rounded to the second, e.g. "3m12s"
+func secondsDuration(seconds float64) string {
+	return time.Duration(seconds * float64(time.Second)).Round(time.Second).String()
+}
+
+// sparkline renders durations as a line of block characters scaled to the longest duration
+func sparkline(durations []time.Duration) string {
+	var longest time.Duration
+	for _, d := range durations {
+		longest = max(longest, d)
+	}
+	if longest <= 0 {
+		return ""
+	}
+
+	var b strings.Builder
+	for _, d := range durations {
+		level := int(float64(len(sparklineBlocks)-1) * float64(d) / float64(longest))
+		b.WriteRune(sparklineBlocks[level])
+	}
+	return b.String()
+}
+
+// renderWorkflowStats renders the run counts, success rate, duration percentiles and duration trend
+func (p *PreviewPanel) renderWorkflowStats(stats *WorkflowStats) string {
+	if stats == nil {
+		return p.styles.GetStatusInProgress().Render("Loading stats...") + "\n"
+	}
+
+	var content strings.Builder
+	content.WriteString(p.styles.GetSubtitle().Render("Runs: "))
+	content.WriteString(fmt.Sprintf("%d (", stats.TotalRuns))
+	content.WriteString(p.styles.StatusStyle("success").Render(fmt.Sprintf("%d success", stats.SuccessCount)))
+	content.WriteString(", ")
+	content.WriteString(p.styles.StatusStyle("failure").Render(fmt.Sprintf("%d failure", stats.FailureCount)))
+	content.WriteString(", ")
+	content.WriteString(p.styles.StatusStyle("cancelled").Render(fmt.Sprintf("%d cancelled", stats.CancelledCount)))
+	content.WriteString(")\n")
+
+	// 完了したランがなければ成功率と実行時間は出さず、最終実行日時などは表示する
+	if stats.Completed == 0 {
+		content.WriteString(p.styles.GetHelp().Render("No completed runs yet"))
+	} else {
+		successRate := stats.SuccessCount * 100 / stats.Completed
+		content.WriteString(p.styles.GetSubtitle().Render("Success: "))
+		content.WriteString(fmt.Sprintf("%d%% (%d/%d)", successRate, stats.SuccessCount, stats.Completed))
+	}
+	content.WriteString("\n")
+
+	if stats.Completed > 0 && stats.AvgDurationSeconds > 0 {
+		content.WriteString(p.styles.GetSubtitle().Render("Duration: "))
+		content.WriteString(fmt.Sprintf("avg %s • p50 %s • p95 %s",
+			secondsDuration(stats.AvgDurationSeconds), secondsDuration(stats.P50DurationSeconds), secondsDuration(stats.P95DurationSeconds)))
+		content.WriteString("\n")
+	}
+
+	if !stats.LastRunAt.IsZero() {
+		content.WriteString(p.styles.GetSubtitle().Render("Last run: "))
+		content.WriteString(fmt.Sprintf("%s (%s)", stats.LastRunAt.Local().Format("2006-01-02 15:04:05"), relativeTime(stats.LastRunAt)))
+		content.WriteString("\n")
+	}
+
+	// 直近10件の実行時間の推移
+	recent := stats.Durations
+	if len(recent) > 10 {
+		recent = recent[len(recent)-10:]
+	}
+	if line := sparkline(recent); line != "" {
+		content.WriteString(p.styles.GetSubtitle().Render("Trend: "))
+		content.WriteString(line)
+		content.WriteString("\n")
+	}
+
+	return content.String()
+}
+
+// ActionsCacheQuota is the Actions cache storage limit of a repository
+const ActionsCacheQuota = 10 << 30
+
+// CacheUsage represents the Actions cache storage used by a repository
+type CacheUsage struct {
+	ActiveBytes int64
+}
+
+// FormatByteSize formats a size in bytes with a binary unit (e.g. "1.5 MiB")
+func FormatByteSize(size int64) string {
+	const unit = 1024
+	if size < unit {
+		return fmt.Sprintf("%d B", size)
+	}
+	div, exp := int64(unit), 0
+	for n := size / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(size)/float64(div), "KMGTPE"[exp])
+}
+
+// renderCacheUsage renders the cache usage against the quota with a bar colored by how full it is
+func (p *PreviewPanel) renderCacheUsage(usage *CacheUsage) string {
+	const barWidth = 20
+	ratio := min(float64(usage.ActiveBytes)/ActionsCacheQuota, 1)
+	filled := int(ratio * barWidth)
+
+	status := "success"
+	switch {
+	case ratio >= 0.9:
+		status = "failure"
+	case ratio >= 0.7:
+		status = "warning"
+	}
+	bar := p.styles.StatusStyle(status).Render(strings.Repeat("█", filled)) + p.styles.GetHelp().Render(strings.Repeat("░", barWidth-filled))
+
+	return p.styles.GetSubtitle().Render("Cache: ") +
+		fmt.Sprintf("%s / %s ", FormatByteSize(usage.ActiveBytes), FormatByteSize(ActionsCacheQuota)) + bar + "\n"
+}
+
+// RenderWorkflowPreview renders the workflow preview with basic information, schedule and recent run statistics.
+// A nil stats is shown as loading and a nil schedule or cache usage is omitted.
+func (p *PreviewPanel) RenderWorkflowPreview(workflow *models.Workflow, stats *WorkflowStats, schedule *WorkflowSchedule, cacheUsage *CacheUsage) string {
 	if workflow == nil {
 		return p.renderEmpty()
 	}
@@ -184,10 +568,25 @@ func (p *PreviewPanel) RenderWorkflowPreview(workflow *models.Workflow) string {
 
 	content.WriteString(p.styles.GetSubtitle().Render("Updated: "))
 	content.WriteString(workflow.UpdatedAt.Format("2006-01-02 15:04:05"))
-	content.WriteString("\n\n")
+	content.WriteString("\n")
+
+	// Actions cache usage of the repository
+	if cacheUsage != nil {
+		content.WriteString(p.renderCacheUsage(cacheUsage))
+	}
+
+	// Schedule (cron) triggers
+	content.WriteString(p.renderWorkflowSchedule(schedule))
+	content.WriteString("\n")
+
+	// Recent run statistics
+	content.WriteString(p.renderWorkflowStats(stats))
+	content.WriteString("\n")
 
 	// Recent activity hint
 	content.WriteString(p.styles.GetHelp().Render("💡 Press Enter to view recent runs for this workflow"))
+	content.WriteString("\n")
+	content.WriteString(p.styles.GetHelp().Render("💡 Press C to inspect and delete the Actions caches"))
 
 	// Wrap in a bordered box
 	boxContent := content.String()
@@ -0,0 +1,78 @@
+package components
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	"gopkg.in/yaml.v3"
+)
+
+// ScheduleEntry is a cron entry of the workflow's schedule trigger
+type ScheduleEntry struct {
+	Cron string
+	Next time.Time // zero if the expression could not be parsed
+}
+
+// WorkflowSchedule represents the schedule (cron) triggers of a workflow
+type WorkflowSchedule struct {
+	Entries []ScheduleEntry
+}
+
+// NewWorkflowSchedule parses the on.schedule[].cron entries of a workflow file
+// and computes their next fire times after now
+func NewWorkflowSchedule(content string, now time.Time) (*WorkflowSchedule, error) {
+	var workflow struct {
+		On yaml.Node `yaml:"on"`
+	}
+	if err := yaml.Unmarshal([]byte(content), &workflow); err != nil {
+		return nil, fmt.Errorf("failed to parse workflow file: %w", err)
+	}
+
+	schedule := &WorkflowSchedule{}
+	// on: push や on: [push, pull_request] の形式にはscheduleがない
+	if workflow.On.Kind != yaml.MappingNode {
+		return schedule, nil
+	}
+	for i := 0; i+1 < len(workflow.On.Content); i += 2 {
+		if workflow.On.Content[i].Value != "schedule" {
+			continue
+		}
+		var entries []struct {
+			Cron string `yaml:"cron"`
+		}
+		if err := workflow.On.Content[i+1].Decode(&entries); err != nil {
+			return nil, fmt.Errorf("failed to parse schedule: %w", err)
+		}
+		for _, entry := range entries {
+			scheduleEntry := ScheduleEntry{Cron: strings.TrimSpace(entry.Cron)}
+			// GitHub Actionsのスケジュールは常にUTC
+			if sched, err := cron.ParseStandard(scheduleEntry.Cron); err == nil {
+				scheduleEntry.Next = sched.Next(now.UTC())
+			}
+			schedule.Entries = append(schedule.Entries, scheduleEntry)
+		}
+	}
+	return schedule, nil
+}
+
+// renderWorkflowSchedule renders the cron entries and their next fire times
+func (p *PreviewPanel) renderWorkflowSchedule(schedule *WorkflowSchedule) string {
+	if schedule == nil || len(schedule.Entries) == 0 {
+		return ""
+	}
+
+	var content strings.Builder
+	for _, entry := range schedule.Entries {
+		content.WriteString(p.styles.GetSubtitle().Render("Schedule: "))
+		content.WriteString(entry.Cron)
+		content.WriteString("\n")
+		if !entry.Next.IsZero() {
+			content.WriteString(p.styles.GetSubtitle().Render("Next: "))
+			content.WriteString(entry.Next.Format("2006-01-02 15:04 MST"))
+			content.WriteString("\n")
+		}
+	}
+	return content.String()
+}
@@ -0,0 +1,24 @@
+package components
+
+// SpinnerFrames are the frames of the loading spinners
+var SpinnerFrames = []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"}
+
+// LoadingSpinner is an animated spinner advanced by the caller on every tick message
+type LoadingSpinner struct {
+	frame int
+}
+
+// Reset moves the spinner back to its first frame
+func (s *LoadingSpinner) Reset() {
+	s.frame = 0
+}
+
+// Tick advances the spinner to its next frame
+func (s *LoadingSpinner) Tick() {
+	s.frame = (s.frame + 1) % len(SpinnerFrames)
+}
+
+// View returns the current frame of the spinner
+func (s LoadingSpinner) View() string {
+	return SpinnerFrames[s.frame]
+}
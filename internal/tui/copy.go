@@ -0,0 +1,75 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/ryo246912/gh-actions-dash/internal/clipboard"
+	"github.com/ryo246912/gh-actions-dash/internal/logs"
+)
+
+// clipboardCopiedMsg reports the result of copying text to the clipboard
+type clipboardCopiedMsg struct {
+	what         string // description of the copied text shown in the status message
+	fallbackPath string // set when the text was written to a file instead of the clipboard
+	err          error
+}
+
+// copyToClipboard copies text to the clipboard in the background
+func copyToClipboard(text, what string) tea.Cmd {
+	return tea.Cmd(func() tea.Msg {
+		path, err := clipboard.WriteOrFallback(text)
+		return clipboardCopiedMsg{what: what, fallbackPath: path, err: err}
+	})
+}
+
+// copyLogLines copies the line at the top of the log view, or all visible lines when all is true
+func (a *App) copyLogLines(all bool) tea.Cmd {
+	if a.logs == "" {
+		return nil
+	}
+
+	lines := strings.Split(a.logs, "\n")
+	count := 1
+	if all {
+		count = a.height - 6 - breadcrumbHeight
+	}
+	end := min(a.logOffset+count, a.logDisplayCount(len(lines)))
+
+	var copied []string
+	for row := a.logOffset; row < end; row++ {
+		copied = append(copied, logs.StripANSI(lines[a.logLineAtRow(row)]))
+	}
+	if len(copied) == 0 {
+		return nil
+	}
+
+	what := ""
+	if len(copied) > 1 {
+		what = fmt.Sprintf("%d lines", len(copied))
+	}
+	return copyToClipboard(strings.Join(copied, "\n"), what)
+}
+
+// copyRunSHA copies the head commit SHA of a run (e.g. for git checkout)
+func (a *App) copyRunSHA(sha string) tea.Cmd {
+	if sha == "" {
+		return nil
+	}
+	return copyToClipboard(sha, "SHA "+sha)
+}
+
+// handleClipboardCopied reports the copy result in the prompt area
+func (a *App) handleClipboardCopied(msg clipboardCopiedMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case msg.err != nil:
+		return a, a.flashStatus(fmt.Sprintf("Failed to copy: %v", msg.err))
+	case msg.fallbackPath != "":
+		return a, a.flashStatus(fmt.Sprintf("No clipboard tool found, saved to %s", msg.fallbackPath))
+	case msg.what != "":
+		return a, a.flashStatus(fmt.Sprintf("Copied %s!", msg.what))
+	default:
+		return a, a.flashStatus("Copied!")
+	}
+}
@@ -0,0 +1,188 @@
+package tui
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/ryo246912/gh-actions-dash/internal/github"
+)
+
+// logSearchState is the search state of the log view, kept aside while the debug log view uses the search
+type logSearchState struct {
+	activeQuery     string
+	matchIndices    []int
+	matchIndex      int
+	invert          bool
+	nonMatchIndices []int
+}
+
+// openDebugLogView switches to the list of the last API requests recorded with --debug
+func (a *App) openDebugLogView() (tea.Model, tea.Cmd) {
+	if !a.client.DebugLogEnabled() {
+		return a, a.flashStatus("Run with --debug to record API requests")
+	}
+	a.debugParentView = a.viewState
+	a.viewState = DebugLogView
+	a.debugEntries = a.client.DebugLog()
+	a.debugOffset = 0
+
+	// ログビューと同じ検索を使うので、ログの検索結果は閉じるまで退避する
+	a.debugSavedSearch = logSearchState{
+		activeQuery:     a.searchActiveQuery,
+		matchIndices:    a.searchMatchIndices,
+		matchIndex:      a.searchMatchIndex,
+		invert:          a.searchInvert,
+		nonMatchIndices: a.searchNonMatchIndices,
+	}
+	a.searchActiveQuery = ""
+	a.searchMatchIndices = nil
+	a.searchMatchIndex = -1
+	a.searchInvert = false
+	a.searchNonMatchIndices = nil
+	return a, nil
+}
+
+// closeDebugLogView returns to the view the debug log was opened from and restores its search
+func (a *App) closeDebugLogView() (tea.Model, tea.Cmd) {
+	a.viewState = a.debugParentView
+	saved := a.debugSavedSearch
+	a.searchActiveQuery = saved.activeQuery
+	a.searchMatchIndices = saved.matchIndices
+	a.searchMatchIndex = saved.matchIndex
+	a.searchInvert = saved.invert
+	a.searchNonMatchIndices = saved.nonMatchIndices
+	a.debugSavedSearch = logSearchState{}
+	return a, nil
+}
+
+// debugLogLines returns the lines of the debug log view, newest request first
+func (a *App) debugLogLines() []string {
+	var lines []string
+	for i := len(a.debugEntries) - 1; i >= 0; i-- {
+		entry := a.debugEntries[i]
+		status := fmt.Sprintf("%d", entry.Status)
+		if entry.Err != nil {
+			status = "ERR " + entry.Err.Error()
+		}
+		lines = append(lines, fmt.Sprintf("%s %s %s %v %s",
+			entry.Time.Format("15:04:05"), entry.Method, status, entry.Duration.Round(time.Millisecond), entry.URL))
+
+		if !a.debugShowBody {
+			continue
+		}
+		if len(entry.Body) == 0 {
+			lines = append(lines, fmt.Sprintf("  (no body captured: %s)", entry.ContentType))
+		} else {
+			lines = append(lines, formatDebugBody(entry.Body)...)
+		}
+		lines = append(lines, "")
+	}
+	return lines
+}
+
+// formatDebugBody indents a JSON response body, falling back to the raw body
+func formatDebugBody(body []byte) []string {
+	var indented bytes.Buffer
+	if err := json.Indent(&indented, body, "  ", "  "); err != nil {
+		return strings.Split("  "+string(body), "\n")
+	}
+	return strings.Split("  "+indented.String(), "\n")
+}
+
+// debugLogViewHeight returns the number of lines shown in the debug log view
+func (a *App) debugLogViewHeight() int {
+	return max(a.height-6-breadcrumbHeight, 1)
+}
+
+// updateDebugSearchMatches searches the debug log lines again after they changed
+func (a *App) updateDebugSearchMatches() {
+	if a.searchActiveQuery == "" {
+		return
+	}
+	matchLine, err := a.logSearchMatcher(a.searchActiveQuery)
+	if err != nil {
+		a.searchMatchIndices = nil
+		return
+	}
+	a.searchMatchIndices = matchingLines(a.debugLogLines(), matchLine)
+	a.searchMatchIndex = -1
+}
+
+// handleDebugLogKey handles the keys of the debug log view with the same scroll and search keys as the log view
+func (a *App) handleDebugLogKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	lines := a.debugLogLines()
+	viewHeight := a.debugLogViewHeight()
+	maxOffset := max(len(lines)-viewHeight, 0)
+
+	switch {
+	case key.Matches(msg, a.keyMap.Back) && a.searchActiveQuery != "":
+		a.searchActiveQuery = ""
+		a.searchMatchIndices = nil
+		a.searchMatchIndex = -1
+	case key.Matches(msg, a.keyMap.Back, a.keyMap.Left), msg.String() == "ctrl+d":
+		return a.goBack()
+	case msg.String() == "/":
+		a.startSearchInput()
+	case msg.String() == "n":
+		a.jumpToNextSearchMatch(true)
+	case msg.String() == "N":
+		a.jumpToNextSearchMatch(false)
+	case msg.String() == "b":
+		a.debugShowBody = !a.debugShowBody
+		a.debugOffset = 0
+		a.updateDebugSearchMatches()
+	case key.Matches(msg, a.keyMap.Refresh):
+		a.debugEntries = a.client.DebugLog()
+		a.debugOffset = 0
+		a.updateDebugSearchMatches()
+	default:
+		a.debugOffset, _ = a.scrollOffset(msg, a.debugOffset, viewHeight, maxOffset)
+	}
+	return a, nil
+}
+
+// renderDebugLogView renders the last API requests with their status, time and optionally their bodies
+func (a *App) renderDebugLogView() string {
+	header := a.styles.GetTitle().Render(fmt.Sprintf("API Debug Log (last %d of %d requests)", len(a.debugEntries), github.DebugLogSize))
+
+	var content string
+	var searchErr error
+	lines := a.debugLogLines()
+	if len(lines) == 0 {
+		content = a.renderEmptyList("📡 まだAPIリクエストが記録されていません", "💡 r で最新のリクエストを読み込み直します")
+	} else {
+		var matchLine func(string) []int
+		if query := a.currentSearchQuery(); query != "" {
+			matchLine, searchErr = a.logSearchMatcher(query)
+		}
+		start := min(a.debugOffset, len(lines))
+		end := min(start+a.debugLogViewHeight(), len(lines))
+		rendered := make([]string, 0, end-start)
+		for _, line := range lines[start:end] {
+			if matchLine != nil {
+				line = highlightSearchMatch(line, matchLine)
+			}
+			rendered = append(rendered, line)
+		}
+		content = strings.Join(rendered, "\n")
+	}
+
+	var prompt string
+	switch {
+	case a.searchInputMode:
+		prompt = a.renderSearchInputPrompt(searchErr)
+	case a.searchActiveQuery != "":
+		prompt = a.styles.GetHelp().Render(fmt.Sprintf("/%s  (n/N: next/prev match, Esc: reset)", a.searchActiveQuery))
+	case a.statusMessage != "":
+		prompt = a.styles.StatusSuccess.Render(a.statusMessage)
+	}
+	help := a.styles.GetHelp().Render("↑/↓: Scroll • /: Search • b: Toggle bodies • r: Reload • Esc/←/Ctrl+D: Back • ?: Help • q: Quit")
+
+	return lipgloss.JoinVertical(lipgloss.Left, header, content, prompt, help)
+}
@@ -0,0 +1,162 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/ryo246912/gh-actions-dash/internal/models"
+)
+
+// dispatchField represents a single field of the workflow_dispatch form
+type dispatchField struct {
+	label string
+	hint  string
+	value string
+	// input is nil for the ref field
+	input *models.WorkflowDispatchInput
+}
+
+type dispatchFormLoadedMsg struct {
+	workflow models.Workflow
+	ref      string
+	inputs   []models.WorkflowDispatchInput
+	err      error
+}
+
+type workflowDispatchedMsg struct {
+	workflow models.Workflow
+	ref      string
+	err      error
+}
+
+// loadDispatchForm fetches the workflow_dispatch inputs and the default branch of the repository
+func (a *App) loadDispatchForm(workflow models.Workflow) tea.Cmd {
+	return tea.Cmd(func() tea.Msg {
+		inputs, err := a.client.GetWorkflowDispatchInputs(a.owner, a.repo, workflow.ID)
+		if err != nil {
+			return dispatchFormLoadedMsg{workflow: workflow, err: err}
+		}
+		defaultBranch, err := a.client.GetDefaultBranch(a.owner, a.repo)
+		if err != nil {
+			return dispatchFormLoadedMsg{workflow: workflow, err: err}
+		}
+		return dispatchFormLoadedMsg{workflow: workflow, ref: defaultBranch, inputs: inputs}
+	})
+}
+
+// triggerWorkflowDispatch sends the workflow_dispatch event
+func (a *App) triggerWorkflowDispatch(workflow models.Workflow, ref string, inputs map[string]string) tea.Cmd {
+	return tea.Cmd(func() tea.Msg {
+		err := a.client.TriggerWorkflowDispatch(a.owner, a.repo, ref, workflow.ID, inputs)
+		return workflowDispatchedMsg{workflow: workflow, ref: ref, err: err}
+	})
+}
+
+// openDispatchForm builds the form fields from the loaded inputs and enters dispatch input mode
+func (a *App) openDispatchForm(msg dispatchFormLoadedMsg) (tea.Model, tea.Cmd) {
+	if msg.err != nil {
+		return a, a.flashStatus(fmt.Sprintf("Cannot dispatch %s: %v", msg.workflow.Name, msg.err))
+	}
+
+	fields := []dispatchField{{label: "ref", hint: "branch or tag", value: msg.ref}}
+	for i := range msg.inputs {
+		input := &msg.inputs[i]
+		hint := input.Type
+		if len(input.Options) > 0 {
+			hint = strings.Join(input.Options, " | ")
+		}
+		if input.Required {
+			hint += ", required"
+		}
+		fields = append(fields, dispatchField{
+			label: input.Name,
+			hint:  hint,
+			value: input.Default,
+			input: input,
+		})
+	}
+
+	a.dispatchInputMode = true
+	a.dispatchWorkflow = msg.workflow
+	a.dispatchFields = fields
+	a.dispatchFieldIndex = 0
+	a.updateListSizes()
+	return a, nil
+}
+
+// closeDispatchForm leaves dispatch input mode
+func (a *App) closeDispatchForm() {
+	a.dispatchInputMode = false
+	a.dispatchFields = nil
+	a.dispatchFieldIndex = 0
+	a.updateListSizes()
+}
+
+// handleDispatchInput handles workflow_dispatch form input mode
+func (a *App) handleDispatchInput(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyTab, tea.KeyDown:
+		a.dispatchFieldIndex = (a.dispatchFieldIndex + 1) % len(a.dispatchFields)
+	case tea.KeyShiftTab, tea.KeyUp:
+		a.dispatchFieldIndex = (a.dispatchFieldIndex - 1 + len(a.dispatchFields)) % len(a.dispatchFields)
+	case tea.KeyEnter:
+		return a.submitDispatchForm()
+	case tea.KeyEsc:
+		a.closeDispatchForm()
+	default:
+		field := &a.dispatchFields[a.dispatchFieldIndex]
+		field.value = editInputBuffer(field.value, msg)
+	}
+	return a, nil
+}
+
+// submitDispatchForm validates the form and triggers the workflow
+func (a *App) submitDispatchForm() (tea.Model, tea.Cmd) {
+	ref := strings.TrimSpace(a.dispatchFields[0].value)
+	if ref == "" {
+		a.dispatchFieldIndex = 0
+		return a, a.flashStatus("ref is required")
+	}
+
+	inputs := make(map[string]string)
+	for i, field := range a.dispatchFields[1:] {
+		value := strings.TrimSpace(field.value)
+		if value == "" {
+			if field.input.Required {
+				a.dispatchFieldIndex = i + 1
+				return a, a.flashStatus(fmt.Sprintf("%s is required", field.label))
+			}
+			continue
+		}
+		inputs[field.label] = value
+	}
+
+	workflow := a.dispatchWorkflow
+	a.closeDispatchForm()
+	return a, a.triggerWorkflowDispatch(workflow, ref, inputs)
+}
+
+// renderDispatchForm renders the workflow_dispatch form below the workflow list
+func (a *App) renderDispatchForm() string {
+	lines := []string{a.styles.GetTitle().Render("Run workflow: " + a.dispatchWorkflow.Name)}
+
+	labelWidth := 0
+	for _, field := range a.dispatchFields {
+		labelWidth = max(labelWidth, len(field.label))
+	}
+
+	for i, field := range a.dispatchFields {
+		line := fmt.Sprintf("%-*s: %s", labelWidth, field.label, field.value)
+		if i == a.dispatchFieldIndex {
+			line = a.styles.HelpKey.Render("> "+line+"_") + "  " + a.styles.HelpDesc.Render("("+field.hint+")")
+		} else {
+			line = a.styles.HelpDesc.Render("  " + line)
+		}
+		lines = append(lines, line)
+	}
+
+	lines = append(lines, a.styles.HelpDesc.Render("Tab/↓: Next field • Shift+Tab/↑: Prev field • Enter: Run • Esc: Cancel"))
+	return lipgloss.JoinVertical(lipgloss.Left, lines...)
+}
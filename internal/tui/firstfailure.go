@@ -0,0 +1,57 @@
+package tui
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ryo246912/gh-actions-dash/internal/models"
+)
+
+// firstFailure returns how long after the start of the run its earliest failed step started.
+// ok is false when no step failed or the timestamps are missing.
+func firstFailure(run *models.WorkflowRun, jobs []models.Job) (offset time.Duration, step models.Step, ok bool) {
+	if run == nil || run.RunStartedAt.IsZero() {
+		return 0, models.Step{}, false
+	}
+	for _, job := range jobs {
+		if job.RunID != 0 && job.RunID != run.ID {
+			// 別のランのジョブ(選択移動直後など)は対象外
+			continue
+		}
+		for _, s := range job.Steps {
+			if s.Conclusion != "failure" || s.StartedAt.IsZero() {
+				continue
+			}
+			if !ok || s.StartedAt.Before(step.StartedAt) {
+				step, ok = s, true
+			}
+		}
+	}
+	if !ok {
+		return 0, models.Step{}, false
+	}
+	return max(step.StartedAt.Sub(run.RunStartedAt), 0), step, true
+}
+
+// formatRunOffset formats a duration into the run, e.g. "2m 14s" or "1h 3m 5s"
+func formatRunOffset(d time.Duration) string {
+	d = d.Round(time.Second)
+	hours, minutes, seconds := int(d.Hours()), int(d.Minutes())%60, int(d.Seconds())%60
+	switch {
+	case hours > 0:
+		return fmt.Sprintf("%dh %dm %ds", hours, minutes, seconds)
+	case minutes > 0:
+		return fmt.Sprintf("%dm %ds", minutes, seconds)
+	default:
+		return fmt.Sprintf("%ds", seconds)
+	}
+}
+
+// renderFirstFailure renders the time to the first failed step of the current run, or "" if no step failed
+func (a *App) renderFirstFailure() string {
+	offset, step, ok := firstFailure(a.currentRun, a.currentJobs)
+	if !ok {
+		return ""
+	}
+	return a.styles.StatusFailure.Render(fmt.Sprintf("First failure at: %s into the run (%s)", formatRunOffset(offset), step.Name))
+}
@@ -0,0 +1,177 @@
+package tui
+
+import (
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/lipgloss/table"
+)
+
+// helpEntry represents a single row in the help overlay
+type helpEntry struct {
+	keys string
+	desc string
+}
+
+// helpSection represents a group of help entries for a context
+type helpSection struct {
+	title   string
+	entries []helpEntry
+}
+
+// bindingEntry converts a key binding into a help entry
+func bindingEntry(b key.Binding) helpEntry {
+	return helpEntry{keys: b.Help().Key, desc: b.Help().Desc}
+}
+
+// helpSections returns all key bindings grouped by context
+func (a *App) helpSections() []helpSection {
+	k := a.keyMap
+	return []helpSection{
+		{
+			title: "Global",
+			entries: []helpEntry{
+				bindingEntry(k.Help),
+				bindingEntry(k.Quit),
+				bindingEntry(k.Back),
+				bindingEntry(k.Refresh),
+				{keys: "w", desc: "workflows"},
+				{keys: "a", desc: "all runs"},
+				{keys: "I", desc: "token info"},
+				{keys: "S", desc: "switch to a recently visited repository"},
+				{keys: "ctrl+d", desc: "API debug log from any view (with --debug; otherwise page down in logs)"},
+			},
+		},
+		{
+			title: "List Navigation",
+			entries: []helpEntry{
+				bindingEntry(k.Up),
+				bindingEntry(k.Down),
+				bindingEntry(k.PageUp),
+				bindingEntry(k.PageDown),
+				bindingEntry(k.Home),
+				bindingEntry(k.End),
+				bindingEntry(k.Enter),
+				bindingEntry(k.NextPage),
+				bindingEntry(k.PrevPage),
+				bindingEntry(k.TogglePreview),
+				{keys: "f", desc: "fuzzy filter"},
+				{keys: "b", desc: "runs for a branch"},
+				{keys: "P", desc: "runs for a pull request"},
+				{keys: "L", desc: "graph of the reusable workflows called by the run"},
+				{keys: "H", desc: "attempts of a re-run run with their jobs"},
+				{keys: "e", desc: "filter all runs by event (esc: clear)"},
+				{keys: "@", desc: "filter runs by actor (esc: clear)"},
+				{keys: "c", desc: "search commit messages of all runs (esc: clear)"},
+				{keys: "d", desc: "run workflow (dispatch)"},
+				{keys: "D", desc: "diff workflow file between two refs"},
+				{keys: "T", desc: "enable/disable workflow"},
+				{keys: "C", desc: "Actions caches (x: delete)"},
+				{keys: "space", desc: "select run"},
+				{keys: "X/R", desc: "cancel/re-run selected runs"},
+				{keys: "tab", desc: "cycle list density"},
+				{keys: "O", desc: "cycle run sort (time, duration, status, name, actor)"},
+				{keys: "A", desc: "approve/reject deployments"},
+				{keys: "o", desc: "open run in browser"},
+				{keys: "y", desc: "copy head commit SHA"},
+			},
+		},
+		{
+			title: "Log View",
+			entries: []helpEntry{
+				{keys: "enter", desc: "expand/collapse step, or show the annotation (✖/⚠) of the line"},
+				{keys: "/", desc: "search"},
+				{keys: "↑/↓", desc: "search history (while searching)"},
+				{keys: "~", desc: "toggle regex (while searching)"},
+				{keys: "ctrl+s/alt+c", desc: "toggle case (while searching)"},
+				{keys: "n/N", desc: "next/prev match"},
+				{keys: "+/-", desc: "more/fewer context lines"},
+				{keys: "!", desc: "show only non-matching lines"},
+				{keys: ":", desc: "jump to line"},
+				{keys: "f/→", desc: "view workflow file"},
+				{keys: "E", desc: "env var names of the workflow, jobs and steps (🔒: likely secret)"},
+				{keys: "s", desc: "save logs to file"},
+				{keys: "y/Y", desc: "copy top line/visible lines"},
+				{keys: "c", desc: "copy head commit SHA"},
+				{keys: "B", desc: "bookmark top line"},
+				{keys: "b", desc: "bookmarks (enter: jump, D: delete)"},
+				{keys: "J", desc: "job selector (matrix jobs grouped)"},
+				{keys: "i", desc: "run metadata (event, ref, commit, actor; esc: close)"},
+				{keys: "l", desc: "show only the selected job's log (in job selector)"},
+				{keys: "A", desc: "approve/reject deployments"},
+				{keys: "o", desc: "open URL on jumped line, else run in browser"},
+				{keys: "a", desc: "artifacts (enter: download)"},
+				{keys: "←", desc: "back"},
+			},
+		},
+		{
+			title: "Workflow File View",
+			entries: []helpEntry{
+				{keys: "esc/←", desc: "close"},
+				bindingEntry(k.Up),
+				bindingEntry(k.Down),
+				bindingEntry(k.PageUp),
+				bindingEntry(k.PageDown),
+				bindingEntry(k.Home),
+				bindingEntry(k.End),
+			},
+		},
+	}
+}
+
+// renderHelpSection renders a help section as an aligned table
+func (a *App) renderHelpSection(section helpSection) string {
+	t := table.New().
+		Border(lipgloss.HiddenBorder()).
+		BorderTop(false).
+		BorderBottom(false).
+		BorderLeft(false).
+		BorderRight(false).
+		BorderColumn(false).
+		StyleFunc(func(row, col int) lipgloss.Style {
+			if col == 0 {
+				return a.styles.HelpKey.PaddingLeft(1).PaddingRight(2)
+			}
+			return a.styles.HelpDesc
+		})
+	for _, entry := range section.entries {
+		t.Row(entry.keys, entry.desc)
+	}
+
+	return lipgloss.JoinVertical(
+		lipgloss.Left,
+		a.styles.GetTitle().Render(section.title),
+		t.Render(),
+		"",
+	)
+}
+
+// renderHelpView renders the full-screen keyboard shortcut help overlay
+func (a *App) renderHelpView() string {
+	sections := a.helpSections()
+	half := (len(sections) + 1) / 2
+
+	var left, right []string
+	for i, section := range sections {
+		if i < half {
+			left = append(left, a.renderHelpSection(section))
+		} else {
+			right = append(right, a.renderHelpSection(section))
+		}
+	}
+
+	body := lipgloss.JoinHorizontal(
+		lipgloss.Top,
+		lipgloss.NewStyle().PaddingRight(4).Render(lipgloss.JoinVertical(lipgloss.Left, left...)),
+		lipgloss.JoinVertical(lipgloss.Left, right...),
+	)
+
+	overlay := a.styles.ActiveBorder.Padding(1, 2).Render(lipgloss.JoinVertical(
+		lipgloss.Left,
+		a.styles.GetTitle().Render("Keyboard Shortcuts"),
+		"",
+		body,
+		a.styles.HelpDesc.PaddingLeft(1).Render("?/Esc/q: Close"),
+	))
+
+	return lipgloss.Place(a.width, a.height, lipgloss.Center, lipgloss.Center, overlay)
+}
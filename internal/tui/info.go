@@ -0,0 +1,95 @@
+package tui
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/ryo246912/gh-actions-dash/internal/github"
+)
+
+// requiredTokenScopes are the OAuth scopes needed to use every feature of the dashboard
+var requiredTokenScopes = []string{"repo", "workflow"}
+
+// tokenInfoLoadedMsg carries the current user and token scopes for the info panel
+type tokenInfoLoadedMsg struct {
+	user   string
+	scopes []string
+	err    error
+}
+
+// openInfoPanel shows the diagnostic info panel and loads the token information
+func (a *App) openInfoPanel() tea.Cmd {
+	a.showInfo = true
+	a.infoLoading = true
+	a.infoUser = ""
+	a.infoScopes = nil
+	a.infoErr = nil
+
+	return tea.Cmd(func() tea.Msg {
+		user, err := a.client.GetCurrentUser()
+		if err != nil {
+			return tokenInfoLoadedMsg{err: err}
+		}
+		scopes, err := a.client.GetTokenScopes()
+		return tokenInfoLoadedMsg{user: user, scopes: scopes, err: err}
+	})
+}
+
+// handleTokenInfoLoaded stores the token information shown in the info panel
+func (a *App) handleTokenInfoLoaded(msg tokenInfoLoadedMsg) (tea.Model, tea.Cmd) {
+	a.infoLoading = false
+	a.infoUser = msg.user
+	a.infoScopes = msg.scopes
+	a.infoErr = msg.err
+	return a, nil
+}
+
+// renderInfoView renders the token diagnostic info panel over the current view
+func (a *App) renderInfoView() string {
+	lines := []string{a.styles.GetTitle().Render("Token Info"), ""}
+
+	switch {
+	case a.infoLoading:
+		lines = append(lines, a.styles.HelpDesc.Render("Loading..."))
+	case a.infoErr != nil && a.infoUser == "":
+		lines = append(lines, a.styles.StatusFailure.Render(fmt.Sprintf("Failed to get token info: %v", a.infoErr)))
+	default:
+		lines = append(lines,
+			a.styles.HelpKey.Render("Host:   ")+a.styles.HelpDesc.Render(a.client.Host()),
+			a.styles.HelpKey.Render("User:   ")+a.styles.HelpDesc.Render(a.infoUser),
+		)
+		if errors.Is(a.infoErr, github.ErrScopesNotReported) {
+			lines = append(lines, a.styles.HelpKey.Render("Scopes: ")+a.styles.HelpDesc.Render("not reported (fine-grained or app token)"))
+			break
+		}
+		if a.infoErr != nil {
+			lines = append(lines, a.styles.StatusFailure.Render(fmt.Sprintf("Failed to get scopes: %v", a.infoErr)))
+			break
+		}
+
+		scopes := "(none)"
+		if len(a.infoScopes) > 0 {
+			scopes = strings.Join(a.infoScopes, ", ")
+		}
+		lines = append(lines, a.styles.HelpKey.Render("Scopes: ")+a.styles.HelpDesc.Render(scopes), "")
+
+		// 必須スコープの有無を表示する(不足分は赤)
+		lines = append(lines, a.styles.HelpKey.Render("Required scopes:"))
+		for _, scope := range requiredTokenScopes {
+			if slices.Contains(a.infoScopes, scope) {
+				lines = append(lines, a.styles.StatusSuccess.Render("  ✓ "+scope))
+			} else {
+				lines = append(lines, a.styles.StatusFailure.Render("  ✗ "+scope+" (missing)"))
+			}
+		}
+	}
+
+	lines = append(lines, "", a.styles.HelpDesc.Render("I/Esc/q: Close"))
+
+	overlay := a.styles.ActiveBorder.Padding(1, 2).Render(lipgloss.JoinVertical(lipgloss.Left, lines...))
+	return lipgloss.Place(a.width, a.height, lipgloss.Center, lipgloss.Center, overlay)
+}
@@ -0,0 +1,69 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/ryo246912/gh-actions-dash/internal/models"
+)
+
+type jobLogsLoadedMsg struct {
+	job     models.Job
+	content string
+	err     error
+}
+
+// loadJobLogs fetches the log of a single job instead of the whole run archive
+func (a *App) loadJobLogs(job models.Job) tea.Cmd {
+	if content, ok := a.jobLogsCache[job.ID]; ok {
+		return func() tea.Msg {
+			return jobLogsLoadedMsg{job: job, content: content}
+		}
+	}
+	return tea.Cmd(func() tea.Msg {
+		content, err := a.client.GetJobLogs(a.owner, a.repo, job.ID)
+		return jobLogsLoadedMsg{job: job, content: content, err: err}
+	})
+}
+
+// handleJobLogsLoaded shows the log of a single job in the log view
+func (a *App) handleJobLogsLoaded(msg jobLogsLoadedMsg) (tea.Model, tea.Cmd) {
+	a.loading = false
+	if msg.err != nil {
+		return a, a.flashStatus(fmt.Sprintf("Failed to get logs of %s: %v", msg.job.Name, msg.err))
+	}
+	// 別のランに移動済みなら表示しない
+	if a.viewState != WorkflowRunLogsView || a.currentRun == nil || a.currentRun.ID != msg.job.RunID {
+		return a, nil
+	}
+
+	a.jobLogsCache[msg.job.ID] = msg.content
+	job := msg.job
+	a.jobLog = &job
+	a.setLogContent(msg.content, nil)
+	return a, nil
+}
+
+// closeJobLog returns from a single job log to the log of the whole run
+func (a *App) closeJobLog() (tea.Model, tea.Cmd) {
+	a.jobLog = nil
+	a.setLogContent("", nil)
+	return a, a.loadWorkflowRunLogs(*a.currentRun)
+}
+
+// setLogContent replaces the log shown in the log view and resets the scroll and search state
+func (a *App) setLogContent(content string, sections []models.LogSection) {
+	a.logs = content
+	a.logSections = sections
+	a.logOffset = 0
+	a.logCursorLine = -1
+	a.searchActiveQuery = ""
+	a.searchMatchIndices = nil
+	a.searchMatchIndex = -1
+	a.searchInvert = false
+	a.searchNonMatchIndices = nil
+	a.stepSections = parseStepLogSections(strings.Split(content, "\n"))
+	a.rebuildLogDisplayLines()
+	a.mapLogAnnotations()
+}
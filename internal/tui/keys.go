@@ -1,133 +0,0 @@
-package tui
-
-import "github.com/charmbracelet/bubbles/key"
-
-// KeyMap defines the key bindings for the TUI
-type KeyMap struct {
-	// Navigation
-	Up       key.Binding
-	Down     key.Binding
-	Left     key.Binding
-	Right    key.Binding
-	PageUp   key.Binding
-	PageDown key.Binding
-	Home     key.Binding
-	End      key.Binding
-
-	// Actions
-	Enter   key.Binding
-	Refresh key.Binding
-	Back    key.Binding
-
-	// Application
-	Quit key.Binding
-	Help key.Binding
-
-	// View switching
-	NextTab key.Binding
-	PrevTab key.Binding
-
-	// Pagination
-	NextPage key.Binding
-	PrevPage key.Binding
-}
-
-// DefaultKeyMap returns a default key map
-func DefaultKeyMap() KeyMap {
-	return KeyMap{
-		// Navigation (Vim-style)
-		Up: key.NewBinding(
-			key.WithKeys("k", "up"),
-			key.WithHelp("k/↑", "move up"),
-		),
-		Down: key.NewBinding(
-			key.WithKeys("j", "down"),
-			key.WithHelp("j/↓", "move down"),
-		),
-		Left: key.NewBinding(
-			key.WithKeys("h", "left"),
-			key.WithHelp("h/←", "move left"),
-		),
-		Right: key.NewBinding(
-			key.WithKeys("l", "right"),
-			key.WithHelp("l/→", "move right"),
-		),
-		PageUp: key.NewBinding(
-			key.WithKeys("ctrl+u", "pgup"),
-			key.WithHelp("ctrl+u/pgup", "page up"),
-		),
-		PageDown: key.NewBinding(
-			key.WithKeys("ctrl+d", "pgdown"),
-			key.WithHelp("ctrl+d/pgdown", "page down"),
-		),
-		Home: key.NewBinding(
-			key.WithKeys("g", "home"),
-			key.WithHelp("g/home", "go to start"),
-		),
-		End: key.NewBinding(
-			key.WithKeys("G", "end"),
-			key.WithHelp("G/end", "go to end"),
-		),
-
-		// Actions
-		Enter: key.NewBinding(
-			key.WithKeys("enter", " "),
-			key.WithHelp("enter/space", "select"),
-		),
-		Refresh: key.NewBinding(
-			key.WithKeys("r"),
-			key.WithHelp("r", "refresh"),
-		),
-		Back: key.NewBinding(
-			key.WithKeys("esc"),
-			key.WithHelp("esc", "back"),
-		),
-
-		// Application
-		Quit: key.NewBinding(
-			key.WithKeys("q", "ctrl+c"),
-			key.WithHelp("q/ctrl+c", "quit"),
-		),
-		Help: key.NewBinding(
-			key.WithKeys("?"),
-			key.WithHelp("?", "help"),
-		),
-
-		// View switching
-		NextTab: key.NewBinding(
-			key.WithKeys("tab"),
-			key.WithHelp("tab", "next tab"),
-		),
-		PrevTab: key.NewBinding(
-			key.WithKeys("shift+tab"),
-			key.WithHelp("shift+tab", "previous tab"),
-		),
-
-		// Pagination
-		NextPage: key.NewBinding(
-			key.WithKeys("n"),
-			key.WithHelp("n", "next page"),
-		),
-		PrevPage: key.NewBinding(
-			key.WithKeys("p"),
-			key.WithHelp("p", "previous page"),
-		),
-	}
-}
-
-// ShortHelp returns keybindings to be shown in the mini help view
-func (k KeyMap) ShortHelp() []key.Binding {
-	return []key.Binding{k.Help, k.Quit}
-}
-
-// FullHelp returns keybindings for the expanded help view
-func (k KeyMap) FullHelp() [][]key.Binding {
-	return [][]key.Binding{
-		{k.Up, k.Down, k.Left, k.Right},
-		{k.PageUp, k.PageDown, k.Home, k.End},
-		{k.Enter, k.Refresh, k.Back},
-		{k.NextTab, k.PrevTab},
-		{k.NextPage, k.PrevPage},
-		{k.Help, k.Quit},
-	}
-}
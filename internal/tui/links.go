@@ -0,0 +1,53 @@
+package tui
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/ryo246912/gh-actions-dash/internal/logs"
+)
+
+// logURLPattern matches http(s) URLs in log lines.
+// ESC is excluded so that a URL at the end of a styled line does not swallow the reset sequence.
+var logURLPattern = regexp.MustCompile(`https?://[^\s\x1b]+`)
+
+// logURLStyle underlines detected URLs in the log view
+var logURLStyle = lipgloss.NewStyle().Underline(true)
+
+// detectLogURLs returns the first URL of each visible line keyed by its log line index
+func (a *App) detectLogURLs(start int, visibleLines []string) map[int]string {
+	urls := make(map[int]string)
+	for i, line := range visibleLines {
+		if url := logURLPattern.FindString(logs.StripANSI(line)); url != "" {
+			urls[a.logLineAtRow(start+i)] = trimURLPunctuation(url)
+		}
+	}
+	return urls
+}
+
+// trimURLPunctuation removes trailing punctuation that usually belongs to the sentence, not the URL
+func trimURLPunctuation(url string) string {
+	return strings.TrimRight(url, ".,;:!?)]}'\"")
+}
+
+// underlineLogURLs underlines the URLs in a rendered log line
+func underlineLogURLs(line string) string {
+	return logURLPattern.ReplaceAllStringFunc(line, func(match string) string {
+		url := trimURLPunctuation(match)
+		return logURLStyle.Render(url) + match[len(url):]
+	})
+}
+
+// cursorLogURL returns the URL on the line the cursor was moved to with jump-to-line or search,
+// or "" when that line is scrolled out of the viewHeight visible rows
+func (a *App) cursorLogURL(lines []string, viewHeight int) string {
+	if a.logCursorLine < 0 || a.logCursorLine >= len(lines) {
+		return ""
+	}
+	row := a.logRowOfLine(a.logCursorLine)
+	if row < a.logOffset || row >= a.logOffset+viewHeight || a.logLineAtRow(row) != a.logCursorLine {
+		return ""
+	}
+	return trimURLPunctuation(logURLPattern.FindString(logs.StripANSI(lines[a.logCursorLine])))
+}
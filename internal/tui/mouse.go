@@ -0,0 +1,137 @@
+package tui
+
+import (
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+const (
+	// doubleClickInterval is the maximum interval between two clicks treated as a double-click
+	doubleClickInterval = 400 * time.Millisecond
+	// mouseWheelLines is the number of log lines scrolled per wheel step
+	mouseWheelLines = 3
+)
+
+// handleMouseMsg handles mouse clicks in list views and wheel scrolling in the log view
+func (a *App) handleMouseMsg(msg tea.MouseMsg) (tea.Model, tea.Cmd) {
+	if a.isInputMode() || a.showHelp || a.showInfo || a.showRunGraph || a.showRepoSwitcher || a.viewingWorkflowFile || a.loading || a.err != nil {
+		return a, nil
+	}
+
+	if a.viewState == WorkflowRunLogsView {
+		if a.showJobSidebar {
+			return a, nil
+		}
+		switch msg.Button {
+		case tea.MouseButtonWheelUp:
+			a.scrollLogs(-mouseWheelLines)
+		case tea.MouseButtonWheelDown:
+			a.scrollLogs(mouseWheelLines)
+		}
+		return a, nil
+	}
+
+	if msg.Action != tea.MouseActionPress || msg.Button != tea.MouseButtonLeft {
+		return a, nil
+	}
+
+	l, delegate, top := a.clickableList()
+	if l == nil {
+		return a, nil
+	}
+	// プレビューパネル側のクリックは無視する
+	if a.previewVisible && msg.X >= a.width-(a.width*2)/5 {
+		return a, nil
+	}
+
+	index, ok := listItemIndexAt(l, delegate, msg.Y-top)
+	if !ok {
+		return a, nil
+	}
+
+	// 同じ行を素早く2回クリックしたらEnterと同じ動作
+	now := time.Now()
+	doubleClick := index == a.lastClickIndex && now.Sub(a.lastClickTime) <= doubleClickInterval
+	a.lastClickIndex = index
+	a.lastClickTime = now
+	if doubleClick {
+		a.lastClickTime = time.Time{}
+		return a.handleEnter()
+	}
+
+	oldIndex := l.Index()
+	l.Select(index)
+	if l.Index() == oldIndex {
+		return a, nil
+	}
+	if a.viewState == WorkflowListView {
+		return a, a.loadSelectedWorkflowDetails()
+	}
+	if run := selectedRunInList(*l); run != nil {
+		return a, a.scheduleJobsLoad(run.ID)
+	}
+	return a, nil
+}
+
+// clickableList returns the list of the current view, its delegate and the screen row
+// where the list (including its title bar) starts
+func (a *App) clickableList() (*list.Model, list.ItemDelegate, int) {
+	var l *list.Model
+	var delegate list.ItemDelegate = a.runDelegate
+	// パンくず + ビューのヘッダー
+	top := breadcrumbHeight + lipgloss.Height(a.styles.GetTitle().Render(""))
+
+	switch a.viewState {
+	case WorkflowListView:
+		l = &a.workflowList
+		delegate = a.workflowDelegate
+	case AllRunsView:
+		l = &a.allRunsList
+	case WorkflowRunsView:
+		l = &a.runsList
+	case BranchRunsView:
+		l = &a.branchRunsList
+	case PRRunsView:
+		l = &a.prRunsList
+	default:
+		return nil, nil, 0
+	}
+	if l != &a.workflowList {
+		// ラン一覧は表のヘッダー行がある
+		top += lipgloss.Height(a.styles.GetHelp().Render(""))
+	}
+	top += lipgloss.Height(l.Styles.TitleBar.Render(l.Styles.Title.Render(l.Title)))
+	return l, delegate, top
+}
+
+// listItemIndexAt returns the index of the item rendered at the given row of the list items area
+func listItemIndexAt(l *list.Model, delegate list.ItemDelegate, row int) (int, bool) {
+	if row < 0 {
+		return 0, false
+	}
+	step := delegate.Height() + delegate.Spacing()
+	if step <= 0 || row%step >= delegate.Height() {
+		return 0, false
+	}
+
+	start, end := l.Paginator.GetSliceBounds(len(l.VisibleItems()))
+	index := start + row/step
+	if index >= end {
+		return 0, false
+	}
+	return index, true
+}
+
+// scrollLogs scrolls the log view by delta rows
+func (a *App) scrollLogs(delta int) {
+	lines := strings.Split(a.logs, "\n")
+	maxOffset := a.logDisplayCount(len(lines)) - (a.height - 6 - breadcrumbHeight)
+	if maxOffset < 0 {
+		maxOffset = 0
+	}
+	a.logOffset = min(max(a.logOffset+delta, 0), maxOffset)
+}
@@ -0,0 +1,44 @@
+package tui
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// paginationSpinnerInterval is the frame interval of the page loading spinner
+const paginationSpinnerInterval = 100 * time.Millisecond
+
+// paginationSpinnerMsg advances the page loading spinner
+type paginationSpinnerMsg struct{}
+
+// startPagination fetches a page in the background while the current page stays interactive.
+// The flag is set before the command is returned so that a second key press cannot start another load.
+func (a *App) startPagination(load tea.Cmd) tea.Cmd {
+	a.paginationInProgress = true
+	a.paginationLoadingSpinner.Reset()
+	return tea.Batch(load, a.tickPaginationSpinner())
+}
+
+// handlePaginationSpinner advances the spinner while a page is being loaded
+func (a *App) handlePaginationSpinner() (tea.Model, tea.Cmd) {
+	if !a.paginationInProgress {
+		return a, nil
+	}
+	a.paginationLoadingSpinner.Tick()
+	return a, a.tickPaginationSpinner()
+}
+
+func (a *App) tickPaginationSpinner() tea.Cmd {
+	return tea.Tick(paginationSpinnerInterval, func(time.Time) tea.Msg {
+		return paginationSpinnerMsg{}
+	})
+}
+
+// paginationSpinner returns the spinner shown in the header, or "" when no page is loading
+func (a *App) paginationSpinner() string {
+	if !a.paginationInProgress {
+		return ""
+	}
+	return " " + a.styles.GetStatusInProgress().Render(a.paginationLoadingSpinner.View()+" Loading page...")
+}
@@ -0,0 +1,107 @@
+package tui
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/ryo246912/gh-actions-dash/internal/models"
+	"github.com/ryo246912/gh-actions-dash/internal/tui/components"
+)
+
+type prRunsPaginatedLoadedMsg struct {
+	runs  []models.WorkflowRun
+	total int
+	page  int
+}
+
+// loadPRRunsPaginated loads the current page of runs triggered by the selected pull request
+func (a *App) loadPRRunsPaginated() tea.Cmd {
+	prNumber := a.prNumber
+	page := a.prRunsPage
+	return tea.Cmd(func() tea.Msg {
+		runs, total, err := a.client.GetWorkflowRunsForPR(a.owner, a.repo, prNumber, page, a.allRunsPerPage)
+		if err != nil {
+			return errorMsg{err: err}
+		}
+		return prRunsPaginatedLoadedMsg{runs: runs, total: total, page: page}
+	})
+}
+
+// handlePRInput handles the PR number input and opens the runs of that pull request
+func (a *App) handlePRInput(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyEnter:
+		input := strings.TrimPrefix(strings.TrimSpace(a.prInputBuffer), "#")
+		a.prInputMode = false
+		a.prInputBuffer = ""
+		if input == "" {
+			return a, nil
+		}
+		number, err := strconv.Atoi(input)
+		if err != nil || number <= 0 {
+			return a, a.flashStatus(fmt.Sprintf("Invalid PR number: %s", input))
+		}
+		a.prNumber = number
+		a.prRunsPage = 1
+		a.prRunsList.ResetSelected()
+		a.viewState = PRRunsView
+		a.loading = true
+		a.updateListSizes()
+		return a, a.loadPRRunsPaginated()
+	case tea.KeyEsc:
+		a.prInputMode = false
+		a.prInputBuffer = ""
+	default:
+		a.prInputBuffer = editInputBuffer(a.prInputBuffer, msg)
+	}
+	return a, nil
+}
+
+// updatePRRunsList updates the PR runs list items
+func (a *App) updatePRRunsList() {
+	items := make([]list.Item, len(a.prRuns))
+	for i, run := range a.prRuns {
+		items[i] = components.WorkflowRunItem{Run: run}
+	}
+	a.prRunsList.SetItems(items)
+
+	// Update list title to show count
+	if len(a.prRuns) == 0 {
+		a.prRunsList.Title = fmt.Sprintf("Workflow Runs for PR #%d (No runs found)", a.prNumber)
+	} else {
+		a.prRunsList.Title = fmt.Sprintf("Workflow Runs for PR #%d (%d)", a.prNumber, len(a.prRuns))
+	}
+}
+
+// renderPRRunsView renders the runs view filtered by pull request
+func (a *App) renderPRRunsView() string {
+	headerText := fmt.Sprintf("Workflow Runs (PR #%d) - %s/%s", a.prNumber, a.owner, a.repo)
+	header := a.styles.GetTitle().Render(headerText) + a.paginationSpinner()
+
+	help := a.styles.GetHelp().Render("Enter: View logs • Esc: Back • a: All runs • r: Refresh • n: Next page • p: Prev page • ?: Help • q: Quit")
+
+	// Pagination info
+	paginationInfo := ""
+	if a.prRunsTotal > 0 {
+		paginationInfo = a.styles.GetHelp().Render(a.getPaginationInfo(a.prRunsPage, a.prRunsTotal, a.allRunsPerPage))
+	}
+
+	// Left side - PR runs list
+	var leftMainContent string
+	if len(a.prRuns) == 0 {
+		leftMainContent = a.renderEmptyList(
+			fmt.Sprintf("📋 PR #%d で実行されたワークフローがありません", a.prNumber),
+			"💡 直近のpull_requestイベントの実行のみを検索します",
+		)
+	} else {
+		leftMainContent = a.renderRunsTable(a.prRunsList)
+	}
+
+	// Right side - preview panel
+	rightContent := a.previewPanel.RenderWorkflowRunPreview(a.withRunUsage(a.withPendingDeployments(selectedRunInList(a.prRunsList))), a.currentJobs)
+
+	return a.renderTwoColumnLayout(a.buildLeftContent(header, leftMainContent, paginationInfo, help), rightContent)
+}
@@ -0,0 +1,139 @@
+package tui
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/ryo246912/gh-actions-dash/internal/models"
+	"github.com/ryo246912/gh-actions-dash/internal/recentrepos"
+)
+
+// RecentRepos returns the recently visited repositories including the current one, most recent first
+func (a *App) RecentRepos() []string {
+	return recentrepos.Add(a.recentRepos, a.owner+"/"+a.repo)
+}
+
+// repoSwitcherEntries returns the recently visited repositories other than the current one
+func (a *App) repoSwitcherEntries() []string {
+	current := a.owner + "/" + a.repo
+	return slices.DeleteFunc(slices.Clone(a.recentRepos), func(entry string) bool { return entry == current })
+}
+
+// openRepoSwitcher shows the list of recently visited repositories
+func (a *App) openRepoSwitcher() tea.Cmd {
+	if len(a.repoSwitcherEntries()) == 0 {
+		return a.flashStatus("No recently visited repositories")
+	}
+	a.showRepoSwitcher = true
+	a.repoSwitcherIndex = 0
+	return nil
+}
+
+// handleRepoSwitcherInput handles the repository switcher
+func (a *App) handleRepoSwitcherInput(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	entries := a.repoSwitcherEntries()
+	switch {
+	case key.Matches(msg, a.keyMap.Up):
+		if a.repoSwitcherIndex > 0 {
+			a.repoSwitcherIndex--
+		}
+	case key.Matches(msg, a.keyMap.Down):
+		if a.repoSwitcherIndex < len(entries)-1 {
+			a.repoSwitcherIndex++
+		}
+	case key.Matches(msg, a.keyMap.Enter):
+		a.showRepoSwitcher = false
+		return a, a.switchRepo(entries[a.repoSwitcherIndex])
+	case key.Matches(msg, a.keyMap.Back, a.keyMap.Quit) || msg.String() == "S":
+		a.showRepoSwitcher = false
+	}
+	return a, nil
+}
+
+// switchRepo resets every per-repository state and loads the runs of fullName ("owner/repo")
+func (a *App) switchRepo(fullName string) tea.Cmd {
+	owner, repo, ok := strings.Cut(fullName, "/")
+	if !ok || owner == "" || repo == "" {
+		return a.flashStatus(fmt.Sprintf("Invalid repository: %s", fullName))
+	}
+
+	a.recentRepos = a.RecentRepos()
+	a.owner = owner
+	a.repo = repo
+	// 前のリポジトリのジョブ読み込み結果を反映させない
+	a.cancelJobsLoad()
+
+	// 一覧・選択状態
+	a.viewState = AllRunsView
+	a.allRuns = nil
+	a.workflows = nil
+	a.workflowRuns = nil
+	a.branchRuns = nil
+	a.prRuns = nil
+	a.currentRun = nil
+	a.currentWorkflow = nil
+	a.currentJobs = nil
+	a.clearRunSelection()
+	a.allRunsList.ResetSelected()
+	a.workflowList.ResetSelected()
+	a.runsList.ResetSelected()
+	a.branchRunsList.ResetSelected()
+	a.prRunsList.ResetSelected()
+
+	// 絞り込み
+	a.branchFilter = ""
+	a.defaultBranchFilter = ""
+	a.prNumber = 0
+	a.eventFilter = ""
+	a.actorFilter = ""
+	a.commitSearchQuery = ""
+	a.allRunsFilter = listFilter{}
+	a.workflowFilter = listFilter{}
+
+	// ページ
+	a.allRunsPage, a.allRunsTotal = 1, 0
+	a.workflowsPage, a.workflowsTotal = 1, 0
+	a.workflowRunsPage, a.workflowRunsTotal = 1, 0
+	a.branchRunsPage, a.branchRunsTotal = 1, 0
+	a.prRunsPage, a.prRunsTotal = 1, 0
+	a.paginationInProgress = false
+
+	// キャッシュ(定期クリーンアップのゴルーチンが参照しているので差し替えずに空にする)
+	a.jobsCache.Clear()
+	a.logsCache.Clear()
+	a.billingCache.Clear()
+	a.artifactsCache.Clear()
+	clear(a.jobLogsCache)
+	clear(a.workflowFileCache)
+	clear(a.workflowStatsCache)
+	clear(a.workflowScheduleCache)
+	a.defaultBranch = ""
+	a.cacheUsage = nil
+	a.cacheUsageRequested = false
+	a.pendingDeploymentsMutex.Lock()
+	a.pendingDeployments = make(map[int64][]models.PendingDeployment)
+	a.pendingDeploymentsMutex.Unlock()
+
+	a.updateAllRunsList()
+	a.loading = true
+	return a.loadAllRunsPaginated()
+}
+
+// renderRepoSwitcherView renders the list of recently visited repositories
+func (a *App) renderRepoSwitcherView() string {
+	lines := []string{a.styles.GetTitle().Render("Switch Repository"), ""}
+	for i, entry := range a.repoSwitcherEntries() {
+		if i == a.repoSwitcherIndex {
+			lines = append(lines, a.styles.HelpKey.Render("> "+entry))
+		} else {
+			lines = append(lines, a.styles.HelpDesc.Render("  "+entry))
+		}
+	}
+	lines = append(lines, "", a.styles.HelpDesc.Render("↑/↓: Select • Enter: Switch • Esc: Cancel"))
+
+	return lipgloss.Place(a.width, a.height, lipgloss.Center, lipgloss.Center, lipgloss.JoinVertical(lipgloss.Left, lines...))
+}
@@ -0,0 +1,179 @@
+package tui
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/ryo246912/gh-actions-dash/internal/github"
+)
+
+// runFilter returns the event and actor filters shared by the run lists
+func (a *App) runFilter() github.RunFilter {
+	return github.RunFilter{Event: a.eventFilter, Actor: a.actorFilter}
+}
+
+// allRunsAPIFilter returns the API filter of the all runs view
+func (a *App) allRunsAPIFilter() github.RunFilter {
+	filter := a.runFilter()
+	filter.Created = a.created
+	filter.Branch = a.defaultBranchFilter
+	return filter
+}
+
+// allRunsFilterLabel describes the filters of the all runs view, e.g. "branch: my-feature, event: push"
+func (a *App) allRunsFilterLabel() string {
+	label := a.runFilterLabel()
+	if a.defaultBranchFilter == "" {
+		return label
+	}
+	if label == "" {
+		return "branch: " + a.defaultBranchFilter
+	}
+	return "branch: " + a.defaultBranchFilter + ", " + label
+}
+
+// runFilterLabel describes the active event and actor filters, e.g. "event: push, actor: dependabot"
+func (a *App) runFilterLabel() string {
+	var parts []string
+	if a.eventFilter != "" {
+		parts = append(parts, "event: "+a.eventFilter)
+	}
+	if a.actorFilter != "" {
+		parts = append(parts, "actor: "+a.actorFilter)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// hasRunFilter reports whether an event or actor filter is active
+func (a *App) hasRunFilter() bool {
+	return a.eventFilter != "" || a.actorFilter != ""
+}
+
+// reloadFilteredRuns reloads the current run list from the first page after a filter change
+func (a *App) reloadFilteredRuns() tea.Cmd {
+	switch a.viewState {
+	case AllRunsView:
+		a.allRunsPage = 1
+	case WorkflowRunsView:
+		a.workflowRunsPage = 1
+	case BranchRunsView:
+		a.branchRunsPage = 1
+	}
+	_, cmd := a.refresh()
+	return cmd
+}
+
+// clearRunFilters clears the event and actor filters and reloads the run list
+func (a *App) clearRunFilters() tea.Cmd {
+	a.eventFilter = ""
+	a.actorFilter = ""
+	return a.reloadFilteredRuns()
+}
+
+// openEventPicker lists the distinct event types of the loaded runs
+func (a *App) openEventPicker() tea.Cmd {
+	var events []string
+	for _, run := range a.allRuns {
+		if run.Event != "" && !slices.Contains(events, run.Event) {
+			events = append(events, run.Event)
+		}
+	}
+	if len(events) == 0 {
+		return a.flashStatus("No event types in the loaded runs")
+	}
+	slices.Sort(events)
+
+	a.eventPickerMode = true
+	a.eventPickerOptions = events
+	a.eventPickerIndex = max(slices.Index(events, a.eventFilter), 0)
+	a.updateListSizes()
+	return nil
+}
+
+// closeEventPicker closes the event type picker
+func (a *App) closeEventPicker() {
+	a.eventPickerMode = false
+	a.eventPickerOptions = nil
+	a.updateListSizes()
+}
+
+// handleEventPickerInput handles the event type picker
+func (a *App) handleEventPickerInput(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case key.Matches(msg, a.keyMap.Up):
+		if a.eventPickerIndex > 0 {
+			a.eventPickerIndex--
+		}
+	case key.Matches(msg, a.keyMap.Down):
+		if a.eventPickerIndex < len(a.eventPickerOptions)-1 {
+			a.eventPickerIndex++
+		}
+	case key.Matches(msg, a.keyMap.Enter):
+		event := a.eventPickerOptions[a.eventPickerIndex]
+		a.closeEventPicker()
+		return a, a.setEventFilter(event)
+	case key.Matches(msg, a.keyMap.Back):
+		// 絞り込みは変更せずに閉じる
+		a.closeEventPicker()
+	}
+	return a, nil
+}
+
+// setEventFilter reloads the all runs view from the first page with the event filter
+func (a *App) setEventFilter(event string) tea.Cmd {
+	a.eventFilter = event
+	return a.reloadFilteredRuns()
+}
+
+// openActorInput opens the actor filter input pre-populated with the actor of the selected run
+func (a *App) openActorInput() {
+	a.actorInputMode = true
+	a.actorInputBuffer = a.actorFilter
+	if run := a.selectedRun(); run != nil {
+		a.actorInputBuffer = run.Actor.Login
+	}
+}
+
+// handleActorInput handles the actor filter input. An empty actor clears the filter.
+func (a *App) handleActorInput(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyEnter:
+		actor := strings.TrimSpace(a.actorInputBuffer)
+		a.actorInputMode = false
+		a.actorInputBuffer = ""
+		if actor == a.actorFilter {
+			return a, nil
+		}
+		a.actorFilter = actor
+		return a, a.reloadFilteredRuns()
+	case tea.KeyEsc:
+		a.actorInputMode = false
+		a.actorInputBuffer = ""
+	default:
+		a.actorInputBuffer = editInputBuffer(a.actorInputBuffer, msg)
+	}
+	return a, nil
+}
+
+// renderActorInput renders the actor filter input prompt
+func (a *App) renderActorInput() string {
+	return a.styles.GetHelp().Render(fmt.Sprintf("actor: %s_  (Enter to filter, empty to clear / Esc to cancel)", a.actorInputBuffer))
+}
+
+// renderEventPicker renders the event type picker
+func (a *App) renderEventPicker() string {
+	lines := []string{a.styles.GetTitle().Render("Filter by event")}
+	for i, event := range a.eventPickerOptions {
+		if i == a.eventPickerIndex {
+			lines = append(lines, a.styles.HelpKey.Render("> "+event))
+		} else {
+			lines = append(lines, a.styles.HelpDesc.Render("  "+event))
+		}
+	}
+	lines = append(lines, a.styles.HelpDesc.Render("↑/↓: Select event • Enter: Filter • Esc: Cancel"))
+	return lipgloss.JoinVertical(lipgloss.Left, lines...)
+}
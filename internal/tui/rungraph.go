@@ -0,0 +1,139 @@
+package tui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/ryo246912/gh-actions-dash/internal/models"
+	"github.com/ryo246912/gh-actions-dash/internal/tui/components"
+)
+
+// runGraphSeparator separates the caller job from the called job in the names of reusable workflow jobs
+const runGraphSeparator = " / "
+
+// runGraphLoadedMsg carries the check runs of the run shown in the graph overlay
+type runGraphLoadedMsg struct {
+	runID int64
+	runs  []models.WorkflowRun
+	err   error
+}
+
+// runGraphNode is a caller or called job of the run graph
+type runGraphNode struct {
+	Name     string
+	Run      *models.WorkflowRun // nil for a caller that has no check run of its own
+	Children []*runGraphNode
+}
+
+// openRunGraph shows the caller/callee graph of the selected run and loads its check runs
+func (a *App) openRunGraph() tea.Cmd {
+	run := a.selectedRun()
+	if run == nil {
+		return nil
+	}
+	a.showRunGraph = true
+	a.runGraphRun = *run
+	a.runGraphRuns = nil
+	a.runGraphErr = nil
+	a.runGraphLoading = true
+
+	return tea.Cmd(func() tea.Msg {
+		runs, err := a.client.GetCheckSuiteRuns(a.owner, a.repo, run.CheckSuiteID)
+		return runGraphLoadedMsg{runID: run.ID, runs: runs, err: err}
+	})
+}
+
+// handleRunGraphLoaded stores the check runs shown in the graph overlay
+func (a *App) handleRunGraphLoaded(msg runGraphLoadedMsg) (tea.Model, tea.Cmd) {
+	// 別のランのグラフを開き直した場合は捨てる
+	if !a.showRunGraph || a.runGraphRun.ID != msg.runID {
+		return a, nil
+	}
+	a.runGraphLoading = false
+	a.runGraphRuns = msg.runs
+	a.runGraphErr = msg.err
+	return a, nil
+}
+
+// buildRunGraph builds the tree from caller to callee out of the "caller / callee" names of the runs.
+// Siblings are ordered by when they started.
+func buildRunGraph(runs []models.WorkflowRun) []*runGraphNode {
+	sorted := append([]models.WorkflowRun(nil), runs...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].RunStartedAt.Before(sorted[j].RunStartedAt)
+	})
+
+	root := &runGraphNode{}
+	for i := range sorted {
+		node := root
+		for _, name := range strings.Split(sorted[i].Name, runGraphSeparator) {
+			var child *runGraphNode
+			for _, c := range node.Children {
+				if c.Name == name {
+					child = c
+					break
+				}
+			}
+			if child == nil {
+				child = &runGraphNode{Name: name}
+				node.Children = append(node.Children, child)
+			}
+			node = child
+		}
+		node.Run = &sorted[i]
+	}
+	return root.Children
+}
+
+// renderRunGraphNodes renders the nodes as an ASCII tree below prefix
+func (a *App) renderRunGraphNodes(nodes []*runGraphNode, prefix string) []string {
+	var lines []string
+	for i, node := range nodes {
+		branch, indent := "├── ", "│   "
+		if i == len(nodes)-1 {
+			branch, indent = "└── ", "    "
+		}
+
+		label := node.Name
+		if node.Run != nil {
+			status := components.GetCIStatus(node.Run.Status, node.Run.Conclusion)
+			label = a.styles.StatusStyle(status).Render(components.StatusIcon(status)) + " " + label
+		} else {
+			// 再利用ワークフローを呼び出したジョブ
+			label = a.styles.HelpKey.Render(label)
+		}
+		lines = append(lines, a.styles.HelpDesc.Render(prefix+branch)+label)
+		lines = append(lines, a.renderRunGraphNodes(node.Children, prefix+indent)...)
+	}
+	return lines
+}
+
+// renderRunGraphView renders the graph overlay of the run and the reusable workflows it calls
+func (a *App) renderRunGraphView() string {
+	run := a.runGraphRun
+	status := components.GetCIStatus(run.Status, run.Conclusion)
+	lines := []string{
+		a.styles.GetTitle().Render(fmt.Sprintf("Run Graph - %s #%d", run.Name, run.RunNumber)),
+		"",
+		a.styles.StatusStyle(status).Render(components.StatusIcon(status)) + " " + run.Name,
+	}
+
+	switch {
+	case a.runGraphLoading:
+		lines = append(lines, a.styles.HelpDesc.Render("Loading..."))
+	case a.runGraphErr != nil:
+		lines = append(lines, a.styles.StatusFailure.Render(fmt.Sprintf("Failed to get check runs: %v", a.runGraphErr)))
+	case len(a.runGraphRuns) == 0:
+		lines = append(lines, a.styles.HelpDesc.Render("(no check runs)"))
+	default:
+		lines = append(lines, a.renderRunGraphNodes(buildRunGraph(a.runGraphRuns), "")...)
+	}
+
+	lines = append(lines, "", a.styles.HelpDesc.Render("L/Esc/q: Close"))
+
+	overlay := a.styles.ActiveBorder.Padding(1, 2).Render(lipgloss.JoinVertical(lipgloss.Left, lines...))
+	return lipgloss.Place(a.width, a.height, lipgloss.Center, lipgloss.Center, overlay)
+}
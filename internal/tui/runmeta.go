@@ -0,0 +1,68 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/ryo246912/gh-actions-dash/internal/tui/components"
+)
+
+// runMetaMaxWidth is the maximum width of the values in the run metadata box
+const runMetaMaxWidth = 40
+
+// renderRunMetaBox renders the event, ref, commit and actor of the current run as a small bordered box
+func (a *App) renderRunMetaBox() string {
+	run := a.currentRun
+	commit := components.ShortSHA(run.HeadSha)
+	if message, _, _ := strings.Cut(run.HeadCommit.Message, "\n"); message != "" {
+		commit += " " + message
+	}
+	actor := run.Actor.Login
+	if run.TriggeringActor.Login != "" && run.TriggeringActor.Login != actor {
+		actor += fmt.Sprintf(" (triggered by %s)", run.TriggeringActor.Login)
+	}
+
+	fields := []struct{ label, value string }{
+		{"Event", run.Event},
+		{"Ref", run.HeadBranch},
+		{"Commit", commit},
+		{"Actor", actor},
+		{"Status", components.GetCIStatus(run.Status, run.Conclusion)},
+	}
+	lines := make([]string, 0, len(fields))
+	for _, field := range fields {
+		value := field.value
+		if value == "" {
+			value = "-"
+		}
+		if runes := []rune(value); len(runes) > runMetaMaxWidth {
+			value = string(runes[:runMetaMaxWidth-3]) + "..."
+		}
+		lines = append(lines, a.styles.HelpKey.Render(fmt.Sprintf("%-7s", field.label))+a.styles.HelpDesc.Render(value))
+	}
+	return a.styles.ActiveBorder.Padding(0, 1).Render(strings.Join(lines, "\n"))
+}
+
+// overlayTopRight draws box over the top-right corner of content without shifting the lines of content
+func overlayTopRight(content, box string, width int) string {
+	boxWidth, boxHeight := lipgloss.Width(box), lipgloss.Height(box)
+	if boxWidth >= width {
+		return content
+	}
+	leftWidth := width - boxWidth
+
+	// 右上に配置したボックスの行を左側のログ行に重ねる(Placeの左側は装飾のない空白になる)
+	placed := strings.Split(lipgloss.Place(width, boxHeight, lipgloss.Right, lipgloss.Top, box), "\n")
+	lines := strings.Split(content, "\n")
+	for len(lines) < boxHeight {
+		lines = append(lines, "")
+	}
+	clip := lipgloss.NewStyle().MaxWidth(leftWidth)
+	for i, overlay := range placed {
+		left := clip.Render(lines[i])
+		left += strings.Repeat(" ", max(leftWidth-lipgloss.Width(left), 0))
+		lines[i] = left + strings.TrimPrefix(overlay, strings.Repeat(" ", leftWidth))
+	}
+	return strings.Join(lines, "\n")
+}
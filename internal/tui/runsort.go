@@ -0,0 +1,78 @@
+package tui
+
+import (
+	"slices"
+	"sort"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/ryo246912/gh-actions-dash/internal/models"
+	"github.com/ryo246912/gh-actions-dash/internal/tui/components"
+)
+
+// Sort keys of the run lists
+const (
+	runSortTime     = "time"
+	runSortDuration = "duration"
+	runSortStatus   = "status"
+	runSortName     = "name"
+	runSortActor    = "actor"
+)
+
+// runSortKeys lists the sort keys in the order O cycles through them
+var runSortKeys = []string{runSortTime, runSortDuration, runSortStatus, runSortName, runSortActor}
+
+// runStatusPriority orders the statuses of the status sort, most urgent first
+var runStatusPriority = []string{"failure", "cancelled", "in_progress", "queued", "success", "skipped"}
+
+// cycleRunSort switches the run lists to the next sort key
+func (a *App) cycleRunSort() tea.Cmd {
+	i := slices.Index(runSortKeys, a.sortKey)
+	a.sortKey = runSortKeys[(i+1)%len(runSortKeys)]
+	a.updateAllRunsList()
+	a.updateWorkflowRunsList()
+	return a.flashStatus("Sort: " + a.sortKey)
+}
+
+// runSortLabel returns the header suffix of the active sort key, or "" for the API order
+func (a *App) runSortLabel() string {
+	if a.sortKey == runSortTime {
+		return ""
+	}
+	return " [sort: " + a.sortKey + "]"
+}
+
+// sortRuns sorts runs in place by key. Runs that compare equal keep the API order (newest first).
+func sortRuns(runs []models.WorkflowRun, key string) {
+	var less func(a, b models.WorkflowRun) bool
+	switch key {
+	case runSortDuration:
+		// 長いランを先頭にする
+		less = func(a, b models.WorkflowRun) bool { return runDuration(a) > runDuration(b) }
+	case runSortStatus:
+		less = func(a, b models.WorkflowRun) bool { return runStatusRank(a) < runStatusRank(b) }
+	case runSortName:
+		less = func(a, b models.WorkflowRun) bool { return a.Name < b.Name }
+	case runSortActor:
+		less = func(a, b models.WorkflowRun) bool { return a.Actor.Login < b.Actor.Login }
+	default:
+		less = func(a, b models.WorkflowRun) bool { return a.CreatedAt.After(b.CreatedAt) }
+	}
+	sort.SliceStable(runs, func(i, j int) bool { return less(runs[i], runs[j]) })
+}
+
+// runDuration returns the time from the start of a run to its last update
+func runDuration(run models.WorkflowRun) time.Duration {
+	if run.RunStartedAt.IsZero() || run.UpdatedAt.IsZero() {
+		return 0
+	}
+	return run.UpdatedAt.Sub(run.RunStartedAt)
+}
+
+// runStatusRank returns the position of the status of a run in runStatusPriority (unknown statuses go last)
+func runStatusRank(run models.WorkflowRun) int {
+	if i := slices.Index(runStatusPriority, components.GetCIStatus(run.Status, run.Conclusion)); i >= 0 {
+		return i
+	}
+	return len(runStatusPriority)
+}
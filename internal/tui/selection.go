@@ -0,0 +1,108 @@
+package tui
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/ryo246912/gh-actions-dash/internal/tui/components"
+)
+
+// batchRunActionMsg reports the result of a batch cancel or re-run
+type batchRunActionMsg struct {
+	action    string
+	succeeded int
+	failed    int
+	err       error // the first error encountered
+}
+
+// selectableRunsList returns the run list of the current view if it supports batch selection
+func (a *App) selectableRunsList() *list.Model {
+	switch a.viewState {
+	case AllRunsView:
+		return &a.allRunsList
+	case WorkflowRunsView:
+		return &a.runsList
+	}
+	return nil
+}
+
+// toggleRunSelection toggles the batch selection of the run under the cursor
+func (a *App) toggleRunSelection(l *list.Model) {
+	item, ok := l.SelectedItem().(components.WorkflowRunItem)
+	if !ok {
+		return
+	}
+
+	if a.selectedRuns[item.Run.ID] {
+		delete(a.selectedRuns, item.Run.ID)
+	} else {
+		a.selectedRuns[item.Run.ID] = true
+	}
+	item.Checked = a.selectedRuns[item.Run.ID]
+	l.SetItem(l.Index(), item)
+	a.runDelegate.SetShowCheckboxes(len(a.selectedRuns) > 0)
+}
+
+// clearRunSelection clears the batch selection and unchecks the items of all run lists
+func (a *App) clearRunSelection() {
+	a.selectedRuns = make(map[int64]bool)
+	a.runDelegate.SetShowCheckboxes(false)
+	for _, l := range []*list.Model{&a.allRunsList, &a.runsList} {
+		for i, listItem := range l.Items() {
+			if item, ok := listItem.(components.WorkflowRunItem); ok && item.Checked {
+				item.Checked = false
+				l.SetItem(i, item)
+			}
+		}
+	}
+}
+
+// batchRunAction cancels or re-runs all selected runs sequentially
+func (a *App) batchRunAction(action string) tea.Cmd {
+	runIDs := make([]int64, 0, len(a.selectedRuns))
+	for id := range a.selectedRuns {
+		runIDs = append(runIDs, id)
+	}
+	sort.Slice(runIDs, func(i, j int) bool { return runIDs[i] < runIDs[j] })
+
+	a.clearRunSelection()
+
+	return tea.Cmd(func() tea.Msg {
+		result := batchRunActionMsg{action: action}
+		for _, id := range runIDs {
+			var err error
+			if action == "cancel" {
+				err = a.client.CancelWorkflowRun(a.owner, a.repo, id)
+			} else {
+				err = a.client.RerunWorkflowRun(a.owner, a.repo, id)
+			}
+			if err != nil {
+				result.failed++
+				if result.err == nil {
+					result.err = err
+				}
+				continue
+			}
+			result.succeeded++
+		}
+		return result
+	})
+}
+
+// handleBatchRunActionResult reports the result of a batch action and refreshes the current list
+func (a *App) handleBatchRunActionResult(msg batchRunActionMsg) (tea.Model, tea.Cmd) {
+	verb := "Cancelled"
+	if msg.action == "rerun" {
+		verb = "Re-ran"
+	}
+
+	status := fmt.Sprintf("%s %d run(s)", verb, msg.succeeded)
+	if msg.failed > 0 {
+		status += fmt.Sprintf(", %d failed: %v", msg.failed, msg.err)
+	}
+
+	_, refreshCmd := a.refresh()
+	return a, tea.Batch(a.flashStatus(status), refreshCmd)
+}
@@ -0,0 +1,219 @@
+package tui
+
+import (
+	"slices"
+	"sort"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/ryo246912/gh-actions-dash/internal/logs"
+)
+
+const (
+	stepGroupMarker    = "##[group]"
+	stepEndGroupMarker = "##[endgroup]"
+)
+
+// stepSeparator returns a separator line of width cells with the step name centered in it.
+// The name is truncated to maxName runes.
+func stepSeparator(name string, width, maxName int) string {
+	name = strings.TrimSpace(logs.StripANSI(name))
+	maxName = min(maxName, width-4)
+	if runes := []rune(name); len(runes) > maxName {
+		if maxName <= 3 {
+			return strings.Repeat("─", width)
+		}
+		name = string(runes[:maxName-3]) + "..."
+	}
+	if name == "" {
+		return strings.Repeat("─", width)
+	}
+
+	label := " " + name + " "
+	rest := max(width-lipgloss.Width(label), 0)
+	left := rest / 2
+	return strings.Repeat("─", left) + label + strings.Repeat("─", rest-left)
+}
+
+// StepLogSection represents a ##[group] ... ##[endgroup] block of the log
+type StepLogSection struct {
+	Name      string
+	Start     int // line index of the ##[group] header
+	End       int // line index of the last line of the section (inclusive)
+	Collapsed bool
+}
+
+// parseStepLogSections finds the ##[group] / ##[endgroup] blocks of the log lines.
+// Sections start collapsed.
+func parseStepLogSections(lines []string) []StepLogSection {
+	var sections []StepLogSection
+	open := -1
+	for i, line := range lines {
+		switch {
+		case strings.Contains(line, stepGroupMarker):
+			// 閉じられていないグループは次のグループの直前で閉じる
+			if open >= 0 {
+				sections[open].End = i - 1
+			}
+			_, name, _ := strings.Cut(line, stepGroupMarker)
+			sections = append(sections, StepLogSection{Name: strings.TrimSpace(name), Start: i, End: i, Collapsed: true})
+			open = len(sections) - 1
+		case strings.Contains(line, stepEndGroupMarker) && open >= 0:
+			sections[open].End = i
+			open = -1
+		}
+	}
+	if open >= 0 {
+		sections[open].End = len(lines) - 1
+	}
+	return sections
+}
+
+// rebuildLogDisplayLines recomputes which log lines are shown with the current collapsed state
+// and the inverted search filter
+func (a *App) rebuildLogDisplayLines() {
+	if len(a.stepSections) == 0 && !a.searchInvert {
+		a.logDisplayLines = nil
+		return
+	}
+
+	total := strings.Count(a.logs, "\n") + 1
+	display := make([]int, 0, total)
+	next := 0
+	for _, section := range a.stepSections {
+		for ; next < section.Start; next++ {
+			display = append(display, next)
+		}
+		// 折りたたみ中はヘッダー行のみ表示する
+		display = append(display, section.Start)
+		if !section.Collapsed {
+			for i := section.Start + 1; i <= section.End; i++ {
+				display = append(display, i)
+			}
+		}
+		next = section.End + 1
+	}
+	for ; next < total; next++ {
+		display = append(display, next)
+	}
+
+	// 反転検索中は一致しなかった行だけを表示する
+	if a.searchInvert {
+		display = slices.DeleteFunc(display, func(line int) bool {
+			_, found := slices.BinarySearch(a.searchNonMatchIndices, line)
+			return !found
+		})
+	}
+	a.logDisplayLines = display
+}
+
+// logDisplayCount returns the number of log rows shown in the log view
+func (a *App) logDisplayCount(totalLines int) int {
+	if a.logDisplayLines == nil {
+		return totalLines
+	}
+	return len(a.logDisplayLines)
+}
+
+// logLineAtRow returns the log line index shown at the given display row
+func (a *App) logLineAtRow(row int) int {
+	if a.logDisplayLines == nil {
+		return row
+	}
+	return a.logDisplayLines[row]
+}
+
+// logRowOfLine returns the display row of a log line index, or of the nearest shown line before it
+func (a *App) logRowOfLine(line int) int {
+	if a.logDisplayLines == nil {
+		return line
+	}
+	row := sort.SearchInts(a.logDisplayLines, line)
+	if row < len(a.logDisplayLines) && a.logDisplayLines[row] == line {
+		return row
+	}
+	return max(row-1, 0)
+}
+
+// stepSectionAt returns the section containing the log line index, or nil
+func (a *App) stepSectionAt(line int) *StepLogSection {
+	i := sort.Search(len(a.stepSections), func(i int) bool {
+		return a.stepSections[i].End >= line
+	})
+	if i < len(a.stepSections) && a.stepSections[i].Start <= line {
+		return &a.stepSections[i]
+	}
+	return nil
+}
+
+// toggleStepSectionAtTop expands or collapses the section shown at the top of the log view
+func (a *App) toggleStepSectionAtTop() {
+	if a.logDisplayLines == nil || a.logOffset >= len(a.logDisplayLines) {
+		return
+	}
+	section := a.stepSectionAt(a.logLineAtRow(a.logOffset))
+	if section == nil {
+		return
+	}
+	section.Collapsed = !section.Collapsed
+	a.rebuildLogDisplayLines()
+	// セクションのヘッダーを先頭に保つ
+	a.logOffset = a.logRowOfLine(section.Start)
+}
+
+// expandStepSectionAt expands the collapsed section containing the log line index
+func (a *App) expandStepSectionAt(line int) {
+	if section := a.stepSectionAt(line); section != nil && section.Collapsed && line != section.Start {
+		section.Collapsed = false
+		a.rebuildLogDisplayLines()
+	}
+}
+
+// toggleSearchInvert switches between highlighting the search matches and showing only the non-matching lines
+func (a *App) toggleSearchInvert() tea.Cmd {
+	if a.searchActiveQuery == "" {
+		return a.flashStatus("Search with / before inverting the match")
+	}
+
+	top := -1
+	if a.logOffset < a.logDisplayCount(strings.Count(a.logs, "\n")+1) {
+		top = a.logLineAtRow(a.logOffset)
+	}
+	if a.searchInvert {
+		a.clearSearchInvert()
+	} else {
+		a.searchInvert = true
+		a.updateSearchNonMatches()
+	}
+	// 表示行が変わっても元の先頭行付近を表示し続ける
+	if top >= 0 {
+		a.logOffset = a.logRowOfLine(top)
+	}
+	return nil
+}
+
+// updateSearchNonMatches recomputes the lines that do not match the search while invert mode is active
+func (a *App) updateSearchNonMatches() {
+	if !a.searchInvert {
+		return
+	}
+	total := strings.Count(a.logs, "\n") + 1
+	a.searchNonMatchIndices = make([]int, 0, total-len(a.searchMatchIndices))
+	for i := 0; i < total; i++ {
+		if _, found := slices.BinarySearch(a.searchMatchIndices, i); !found {
+			a.searchNonMatchIndices = append(a.searchNonMatchIndices, i)
+		}
+	}
+	a.rebuildLogDisplayLines()
+}
+
+// clearSearchInvert turns off invert mode and shows all lines again
+func (a *App) clearSearchInvert() {
+	if !a.searchInvert {
+		return
+	}
+	a.searchInvert = false
+	a.searchNonMatchIndices = nil
+	a.rebuildLogDisplayLines()
+}
@@ -7,9 +7,10 @@ import (
 // Styles defines the styling for the TUI
 type Styles struct {
 	// Base styles
-	Base     lipgloss.Style
-	Title    lipgloss.Style
-	Subtitle lipgloss.Style
+	Base                lipgloss.Style
+	Title               lipgloss.Style
+	Subtitle            lipgloss.Style
+	BreadcrumbSeparator lipgloss.Style
 
 	// List styles
 	List         lipgloss.Style
@@ -28,8 +29,12 @@ type Styles struct {
 	ActiveBorder lipgloss.Style
 
 	// Content styles
-	Content lipgloss.Style
-	Sidebar lipgloss.Style
+	Content       lipgloss.Style
+	Sidebar       lipgloss.Style
+	SearchContext lipgloss.Style
+	DiffInsert    lipgloss.Style
+	DiffDelete    lipgloss.Style
+	SelectionBar  lipgloss.Style
 
 	// Help styles
 	Help     lipgloss.Style
@@ -104,6 +109,9 @@ func DefaultStyles() Styles {
 			Foreground(mutedColor).
 			Padding(0, 1),
 
+		BreadcrumbSeparator: lipgloss.NewStyle().
+			Foreground(primaryColor),
+
 		List: baseBorder.
 			Padding(1, 2),
 
@@ -148,6 +156,22 @@ func DefaultStyles() Styles {
 			Padding(1, 2).
 			Width(30),
 
+		SearchContext: lipgloss.NewStyle().
+			Foreground(mutedColor).
+			Background(lipgloss.Color("236")),
+
+		DiffInsert: lipgloss.NewStyle().
+			Foreground(successColor),
+
+		DiffDelete: lipgloss.NewStyle().
+			Foreground(failureColor),
+
+		SelectionBar: lipgloss.NewStyle().
+			Foreground(lipgloss.Color("#111827")).
+			Background(warningColor).
+			Bold(true).
+			Padding(0, 1),
+
 		Help: lipgloss.NewStyle().
 			Foreground(mutedColor).
 			Padding(1, 2),
@@ -0,0 +1,122 @@
+package tui
+
+import (
+	"sync"
+	"time"
+)
+
+// ttlCacheEntry represents a cached value with timestamp and its own TTL
+type ttlCacheEntry[V any] struct {
+	value     V
+	timestamp time.Time
+	ttl       time.Duration
+}
+
+// ttlCache represents a cache whose entries expire after a TTL.
+// Once maxEntries keys are cached the oldest entry is evicted (0 or less means no limit).
+type ttlCache[K comparable, V any] struct {
+	mu         sync.RWMutex
+	entries    map[K]ttlCacheEntry[V]
+	ttl        time.Duration
+	maxEntries int
+}
+
+// newTTLCache creates a new cache with the default TTL of its entries and a size limit
+func newTTLCache[K comparable, V any](ttl time.Duration, maxEntries int) *ttlCache[K, V] {
+	return &ttlCache[K, V]{
+		entries:    make(map[K]ttlCacheEntry[V]),
+		ttl:        ttl,
+		maxEntries: maxEntries,
+	}
+}
+
+// Get retrieves a value from cache if not expired
+func (c *ttlCache[K, V]) Get(key K) (V, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	entry, exists := c.entries[key]
+	if !exists || time.Since(entry.timestamp) > c.entryTTL(entry) {
+		var zero V
+		return zero, false
+	}
+
+	return entry.value, true
+}
+
+// Set stores a value in cache with current timestamp and the TTL of the cache
+func (c *ttlCache[K, V]) Set(key K, value V) {
+	c.SetWithTTL(key, value, 0)
+}
+
+// SetWithTTL stores a value in cache with current timestamp.
+// A ttl of 0 or less uses the TTL of the cache.
+func (c *ttlCache[K, V]) SetWithTTL(key K, value V, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// 上限に達したら最も古いエントリを削除する
+	if _, exists := c.entries[key]; !exists && c.maxEntries > 0 && len(c.entries) >= c.maxEntries {
+		c.evictOldest()
+	}
+
+	c.entries[key] = ttlCacheEntry[V]{
+		value:     value,
+		timestamp: time.Now(),
+		ttl:       ttl,
+	}
+}
+
+// entryTTL returns the TTL of an entry, falling back to the cache TTL
+func (c *ttlCache[K, V]) entryTTL(entry ttlCacheEntry[V]) time.Duration {
+	if entry.ttl > 0 {
+		return entry.ttl
+	}
+	return c.ttl
+}
+
+// evictOldest removes the entry with the oldest timestamp. The caller must hold the lock.
+func (c *ttlCache[K, V]) evictOldest() {
+	var oldestKey K
+	var oldest time.Time
+	found := false
+	for key, entry := range c.entries {
+		if !found || entry.timestamp.Before(oldest) {
+			oldestKey = key
+			oldest = entry.timestamp
+			found = true
+		}
+	}
+	if found {
+		delete(c.entries, oldestKey)
+	}
+}
+
+// Delete removes a value from the cache
+func (c *ttlCache[K, V]) Delete(key K) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.entries, key)
+}
+
+// Cleanup removes expired entries
+func (c *ttlCache[K, V]) Cleanup() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	for key, entry := range c.entries {
+		if now.Sub(entry.timestamp) > c.entryTTL(entry) {
+			delete(c.entries, key)
+		}
+	}
+}
+
+// Clear removes every entry
+func (c *ttlCache[K, V]) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	clear(c.entries)
+}
@@ -0,0 +1,107 @@
+package tui
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/ryo246912/gh-actions-dash/internal/models"
+)
+
+// watchInterval is the polling interval of watch mode
+const watchInterval = 15 * time.Second
+
+// watchTickMsg triggers a watch mode poll
+type watchTickMsg struct{}
+
+// watchRunsLoadedMsg carries the runs fetched by a watch mode poll
+type watchRunsLoadedMsg struct {
+	runs  []models.WorkflowRun
+	total int
+	err   error
+}
+
+// scheduleWatch schedules the next watch mode poll
+func (a *App) scheduleWatch() tea.Cmd {
+	if !a.watch {
+		return nil
+	}
+	return tea.Tick(watchInterval, func(time.Time) tea.Msg {
+		return watchTickMsg{}
+	})
+}
+
+// pollWatchRuns fetches the first page of all runs for watch mode
+func (a *App) pollWatchRuns() tea.Cmd {
+	return tea.Cmd(func() tea.Msg {
+		runs, total, err := a.client.GetAllWorkflowRunsPaginated(a.owner, a.repo, 1, a.allRunsPerPage, a.allRunsAPIFilter())
+		return watchRunsLoadedMsg{runs: runs, total: total, err: err}
+	})
+}
+
+// handleWatchRunsLoaded moves to the latest in-progress run, or stops watching once every run has completed
+func (a *App) handleWatchRunsLoaded(msg watchRunsLoadedMsg) (tea.Model, tea.Cmd) {
+	if msg.err != nil {
+		return a, tea.Batch(a.flashStatus(fmt.Sprintf("Watch: failed to fetch runs: %v", msg.err)), a.scheduleWatch())
+	}
+
+	a.allRuns = msg.runs
+	a.allRunsTotal = msg.total
+	a.allRunsPage = 1
+	a.updateAllRunsList()
+
+	var inProgress []int
+	allCompleted := true
+	for i, run := range a.allRuns {
+		if run.Status == "in_progress" {
+			inProgress = append(inProgress, i)
+		}
+		if run.Status != "completed" {
+			allCompleted = false
+		}
+	}
+
+	if allCompleted {
+		a.watch = false
+		a.logTail = false
+		return a, tea.Batch(a.flashStatus("Watch: all runs completed"), ringBell)
+	}
+
+	cmds := []tea.Cmd{a.scheduleWatch(), a.scheduleBlink()}
+	if len(inProgress) == 0 {
+		return a, tea.Batch(cmds...)
+	}
+
+	a.allRunsList.Select(inProgress[0])
+	run := a.allRuns[inProgress[0]]
+
+	// 入力中やほかの画面の操作中は画面を切り替えない
+	if len(inProgress) != 1 || a.isInputMode() || a.showHelp || a.showInfo || a.showRunGraph || a.showRepoSwitcher || a.viewingWorkflowFile {
+		return a, tea.Batch(cmds...)
+	}
+	switch {
+	case a.viewState == AllRunsView:
+		_, openCmd := a.openRunLogs(run)
+		a.logTail = true
+		cmds = append(cmds, openCmd)
+	case a.viewState == WorkflowRunLogsView && a.logTail && a.jobLog == nil && a.currentRun != nil && a.currentRun.ID == run.ID:
+		// 同じランを表示中なら最新のログを取り直して末尾を追う
+		a.logsCache.Delete(run.ID)
+		cmds = append(cmds, a.loadWorkflowRunLogs(run), a.loadWorkflowRunJobs(run.ID))
+	}
+	return a, tea.Batch(cmds...)
+}
+
+// scrollLogsToTail scrolls the logs view to the last lines
+func (a *App) scrollLogsToTail() {
+	viewHeight := a.height - 6 - breadcrumbHeight
+	a.logOffset = max(a.logDisplayCount(strings.Count(a.logs, "\n")+1)-viewHeight, 0)
+}
+
+// ringBell rings the terminal bell
+func ringBell() tea.Msg {
+	_, _ = fmt.Fprint(os.Stderr, "\a")
+	return nil
+}
@@ -0,0 +1,141 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/ryo246912/gh-actions-dash/internal/diff"
+	"github.com/ryo246912/gh-actions-dash/internal/models"
+)
+
+type workflowDiffLoadedMsg struct {
+	path string
+	base string
+	head string
+	diff []diff.Line
+	err  error
+}
+
+// openWorkflowDiffInput starts asking for the two refs to compare the workflow file between
+func (a *App) openWorkflowDiffInput(workflow models.Workflow) {
+	a.diffInputMode = true
+	a.diffInputBuffer = ""
+	a.diffBaseRef = ""
+	a.diffWorkflow = workflow
+}
+
+// handleDiffInput handles the base and head ref inputs of the workflow diff
+func (a *App) handleDiffInput(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyEnter:
+		ref := strings.TrimSpace(a.diffInputBuffer)
+		if ref == "" {
+			return a, nil
+		}
+		a.diffInputBuffer = ""
+		// 1つ目の入力はbase、2つ目はhead
+		if a.diffBaseRef == "" {
+			a.diffBaseRef = ref
+			return a, nil
+		}
+		a.diffInputMode = false
+		return a, a.loadWorkflowDiff(a.diffWorkflow.Path, a.diffBaseRef, ref)
+	case tea.KeyEsc:
+		a.diffInputMode = false
+		a.diffInputBuffer = ""
+		a.diffBaseRef = ""
+	default:
+		a.diffInputBuffer = editInputBuffer(a.diffInputBuffer, msg)
+	}
+	return a, nil
+}
+
+// loadWorkflowDiff fetches the workflow file at both refs and opens the diff in the workflow file viewer
+func (a *App) loadWorkflowDiff(path, base, head string) tea.Cmd {
+	a.viewingWorkflowFile = true
+	a.workflowFileLoading = true
+	a.workflowFileContent = ""
+	a.workflowFileErrors = nil
+	a.workflowFileOffset = 0
+	a.workflowFilePath = path
+	a.workflowFileRef = base + ".." + head
+
+	return tea.Cmd(func() tea.Msg {
+		msg := workflowDiffLoadedMsg{path: path, base: base, head: head}
+		baseContent, err := a.client.GetWorkflowFileAtRef(a.owner, a.repo, path, base)
+		if err != nil {
+			msg.err = fmt.Errorf("failed to fetch %s at %s: %w", path, base, err)
+			return msg
+		}
+		headContent, err := a.client.GetWorkflowFileAtRef(a.owner, a.repo, path, head)
+		if err != nil {
+			msg.err = fmt.Errorf("failed to fetch %s at %s: %w", path, head, err)
+			return msg
+		}
+		msg.diff = diff.Lines(strings.Split(baseContent, "\n"), strings.Split(headContent, "\n"))
+		return msg
+	})
+}
+
+// handleWorkflowDiffLoaded shows the computed diff in the workflow file viewer
+func (a *App) handleWorkflowDiffLoaded(msg workflowDiffLoadedMsg) (tea.Model, tea.Cmd) {
+	// 取得中に閉じられていたら何もしない
+	if !a.viewingWorkflowFile || a.workflowFileRef != msg.base+".."+msg.head {
+		return a, nil
+	}
+	a.workflowFileLoading = false
+	if msg.err != nil {
+		a.workflowFileContent = msg.err.Error()
+		return a, nil
+	}
+	a.workflowFileDiff = msg.diff
+	return a, nil
+}
+
+// workflowFileLineCount returns the number of lines shown in the workflow file viewer
+func (a *App) workflowFileLineCount() int {
+	if a.workflowFileDiff != nil {
+		return len(a.workflowFileDiff)
+	}
+	return len(strings.Split(a.workflowFileContent, "\n"))
+}
+
+// renderWorkflowDiffLine renders a diff line with its +/-/space gutter marker
+func (a *App) renderWorkflowDiffLine(line diff.Line) string {
+	switch line.Op {
+	case diff.Insert:
+		return a.styles.DiffInsert.Render("+ " + line.Text)
+	case diff.Delete:
+		return a.styles.DiffDelete.Render("- " + line.Text)
+	default:
+		return "  " + line.Text
+	}
+}
+
+// renderWorkflowDiff renders the visible part of the workflow file diff
+func (a *App) renderWorkflowDiff() string {
+	viewHeight := max(a.height-4-breadcrumbHeight, 1) // breadcrumb + header + help
+	start := min(a.workflowFileOffset, len(a.workflowFileDiff))
+	end := min(start+viewHeight, len(a.workflowFileDiff))
+
+	visible := make([]string, 0, end-start)
+	for _, line := range a.workflowFileDiff[start:end] {
+		visible = append(visible, a.renderWorkflowDiffLine(line))
+	}
+	if len(visible) == 0 {
+		return a.styles.GetHelp().Render("(no differences)")
+	}
+	return lipgloss.NewStyle().Width(a.width - 4).Render(strings.Join(visible, "\n"))
+}
+
+// diffHasChanges reports whether the diff contains any inserted or deleted line
+func diffHasChanges(lines []diff.Line) bool {
+	for _, line := range lines {
+		if line.Op != diff.Equal {
+			return true
+		}
+	}
+	return false
+}
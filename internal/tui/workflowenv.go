@@ -0,0 +1,193 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"gopkg.in/yaml.v3"
+)
+
+// workflowEnvLoadedMsg carries the workflow file of the run shown in the env overlay
+type workflowEnvLoadedMsg struct {
+	runID int64
+	file  workflowFileLoadedMsg
+}
+
+// workflowEnvScope is the names of the env: block of the workflow, a job or a step
+type workflowEnvScope struct {
+	Label string
+	Names []string
+}
+
+// secretEnvSuffixes are the name suffixes of env vars that likely hold a secret
+var secretEnvSuffixes = []string{"_TOKEN", "_SECRET", "_KEY"}
+
+// isSecretEnvName reports whether an env var name looks like it holds a secret
+func isSecretEnvName(name string) bool {
+	upper := strings.ToUpper(name)
+	for _, suffix := range secretEnvSuffixes {
+		if strings.HasSuffix(upper, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseWorkflowEnv returns the env var names of the workflow, job and step env: blocks in file order.
+// Only the names are read so that values (which may embed secrets) are never shown.
+func parseWorkflowEnv(content string) []workflowEnvScope {
+	var doc yaml.Node
+	if err := yaml.Unmarshal([]byte(content), &doc); err != nil || len(doc.Content) == 0 {
+		return nil
+	}
+	root := doc.Content[0]
+
+	var scopes []workflowEnvScope
+	if names := envNames(mappingValue(root, "env")); len(names) > 0 {
+		scopes = append(scopes, workflowEnvScope{Label: "workflow", Names: names})
+	}
+
+	jobs := mappingValue(root, "jobs")
+	if jobs == nil || jobs.Kind != yaml.MappingNode {
+		return scopes
+	}
+	for i := 0; i+1 < len(jobs.Content); i += 2 {
+		jobID, job := jobs.Content[i].Value, jobs.Content[i+1]
+		if names := envNames(mappingValue(job, "env")); len(names) > 0 {
+			scopes = append(scopes, workflowEnvScope{Label: "job " + jobID, Names: names})
+		}
+
+		steps := mappingValue(job, "steps")
+		if steps == nil || steps.Kind != yaml.SequenceNode {
+			continue
+		}
+		for j, step := range steps.Content {
+			names := envNames(mappingValue(step, "env"))
+			if len(names) == 0 {
+				continue
+			}
+			scopes = append(scopes, workflowEnvScope{Label: fmt.Sprintf("job %s / step %s", jobID, stepLabel(step, j)), Names: names})
+		}
+	}
+	return scopes
+}
+
+// mappingValue returns the value of a key of a mapping node, or nil
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// envNames returns the keys of an env: mapping. An expression such as ${{ fromJSON(...) }} has no names.
+func envNames(env *yaml.Node) []string {
+	if env == nil || env.Kind != yaml.MappingNode {
+		return nil
+	}
+	names := make([]string, 0, len(env.Content)/2)
+	for i := 0; i+1 < len(env.Content); i += 2 {
+		names = append(names, env.Content[i].Value)
+	}
+	return names
+}
+
+// stepLabel returns the name of a step, falling back to its id, action or 1-based position
+func stepLabel(step *yaml.Node, index int) string {
+	for _, key := range []string{"name", "id", "uses"} {
+		if value := mappingValue(step, key); value != nil && value.Value != "" {
+			return value.Value
+		}
+	}
+	return fmt.Sprintf("#%d", index+1)
+}
+
+// openWorkflowEnv shows the env var names of the current run's workflow file,
+// loading the file unless it is already cached
+func (a *App) openWorkflowEnv() tea.Cmd {
+	path, ref := a.runWorkflowFile()
+	if path == "" {
+		return a.flashStatus("No workflow file for this run")
+	}
+	a.showWorkflowEnv = true
+	a.workflowEnvRun = *a.currentRun
+	a.workflowEnvContent = ""
+	a.workflowEnvErr = nil
+
+	// fで表示したファイルのキャッシュを共有する
+	if cached, ok := a.workflowFileCache[path+"@"+ref]; ok {
+		a.workflowEnvContent = cached
+		a.workflowEnvLoading = false
+		return nil
+	}
+	a.workflowEnvLoading = true
+	runID := a.currentRun.ID
+	return tea.Cmd(func() tea.Msg {
+		return workflowEnvLoadedMsg{runID: runID, file: a.fetchWorkflowFile(path, ref)}
+	})
+}
+
+// handleWorkflowEnvLoaded caches the loaded workflow file and shows its env var names
+func (a *App) handleWorkflowEnvLoaded(msg workflowEnvLoadedMsg) (tea.Model, tea.Cmd) {
+	if msg.file.defaultBranch != "" {
+		a.defaultBranch = msg.file.defaultBranch
+	}
+	if msg.file.err == nil {
+		a.workflowFileCache[msg.file.path+"@"+msg.file.ref] = msg.file.content
+	}
+	// 別のランで開き直した場合は表示を更新しない
+	if !a.showWorkflowEnv || a.workflowEnvRun.ID != msg.runID {
+		return a, nil
+	}
+	a.workflowEnvLoading = false
+	a.workflowEnvContent = msg.file.content
+	a.workflowEnvErr = msg.file.err
+	return a, nil
+}
+
+// renderWorkflowEnv renders the env var names of the workflow file content, marking the likely secrets with 🔒
+func (a *App) renderWorkflowEnv(content string) string {
+	scopes := parseWorkflowEnv(content)
+	if len(scopes) == 0 {
+		return a.styles.GetHelp().Render("(no env: blocks in this workflow)")
+	}
+
+	lines := make([]string, 0, len(scopes))
+	for _, scope := range scopes {
+		names := make([]string, len(scope.Names))
+		for i, name := range scope.Names {
+			if isSecretEnvName(name) {
+				name = "🔒" + name
+			}
+			names[i] = name
+		}
+		lines = append(lines, a.styles.HelpKey.Render(scope.Label)+"\n  "+a.styles.HelpDesc.Render("Env: "+strings.Join(names, ", ")))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// renderWorkflowEnvView renders the overlay listing the env var names of the run's workflow file
+func (a *App) renderWorkflowEnvView() string {
+	lines := []string{a.styles.GetTitle().Render(fmt.Sprintf("Env - %s #%d", a.workflowEnvRun.Name, a.workflowEnvRun.RunNumber)), ""}
+
+	switch {
+	case a.workflowEnvLoading:
+		lines = append(lines, a.styles.HelpDesc.Render("Loading..."))
+	case a.workflowEnvErr != nil:
+		lines = append(lines, a.styles.StatusFailure.Render(fmt.Sprintf("Failed to fetch workflow file: %v", a.workflowEnvErr)))
+	default:
+		lines = append(lines, a.renderWorkflowEnv(a.workflowEnvContent))
+	}
+
+	lines = append(lines, "", a.styles.HelpDesc.Render("Names only, values are never shown • E/Esc/q: Close"))
+
+	overlay := a.styles.ActiveBorder.Padding(1, 2).Render(lipgloss.JoinVertical(lipgloss.Left, lines...))
+	return lipgloss.Place(a.width, a.height, lipgloss.Center, lipgloss.Center, overlay)
+}
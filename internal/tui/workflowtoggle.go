@@ -0,0 +1,82 @@
+package tui
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/ryo246912/gh-actions-dash/internal/models"
+	"github.com/ryo246912/gh-actions-dash/internal/tui/components"
+)
+
+// workflowToggledMsg reports the result of enabling or disabling a workflow
+type workflowToggledMsg struct {
+	workflowID int64
+	name       string
+	state      string // the state after the toggle
+	err        error
+}
+
+// openWorkflowToggleConfirm asks for confirmation before enabling or disabling the selected workflow
+func (a *App) openWorkflowToggleConfirm() {
+	item, ok := a.workflowList.SelectedItem().(components.WorkflowItem)
+	if !ok {
+		return
+	}
+	workflow := item.Workflow
+	a.workflowToggleTarget = &workflow
+}
+
+// handleWorkflowToggleConfirm handles the y/n answer of the toggle confirmation
+func (a *App) handleWorkflowToggleConfirm(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	workflow := *a.workflowToggleTarget
+	switch {
+	case msg.String() == "y" || key.Matches(msg, a.keyMap.Enter):
+		a.workflowToggleTarget = nil
+		return a, a.toggleWorkflow(workflow)
+	case msg.String() == "n" || key.Matches(msg, a.keyMap.Back):
+		a.workflowToggleTarget = nil
+	}
+	return a, nil
+}
+
+// toggleWorkflow disables an active workflow or enables a disabled one
+func (a *App) toggleWorkflow(workflow models.Workflow) tea.Cmd {
+	return tea.Cmd(func() tea.Msg {
+		if workflow.State == "active" {
+			err := a.client.DisableWorkflow(a.owner, a.repo, workflow.ID)
+			return workflowToggledMsg{workflowID: workflow.ID, name: workflow.Name, state: "disabled_manually", err: err}
+		}
+		err := a.client.EnableWorkflow(a.owner, a.repo, workflow.ID)
+		return workflowToggledMsg{workflowID: workflow.ID, name: workflow.Name, state: "active", err: err}
+	})
+}
+
+// handleWorkflowToggled updates the workflow state locally instead of reloading the whole list
+func (a *App) handleWorkflowToggled(msg workflowToggledMsg) (tea.Model, tea.Cmd) {
+	if msg.err != nil {
+		return a, a.flashStatus(fmt.Sprintf("Failed to toggle %s: %v", msg.name, msg.err))
+	}
+
+	for i := range a.workflows {
+		if a.workflows[i].ID == msg.workflowID {
+			a.workflows[i].State = msg.state
+		}
+	}
+	a.updateWorkflowList()
+
+	verb := "Disabled"
+	if msg.state == "active" {
+		verb = "Enabled"
+	}
+	return a, a.flashStatus(fmt.Sprintf("%s workflow %s", verb, msg.name))
+}
+
+// renderWorkflowToggleConfirm renders the confirmation prompt of the workflow toggle
+func (a *App) renderWorkflowToggleConfirm() string {
+	action := "Enable"
+	if a.workflowToggleTarget.State == "active" {
+		action = "Disable"
+	}
+	return a.styles.StatusStyle("warning").Render(fmt.Sprintf("%s workflow %s? (y/n)", action, a.workflowToggleTarget.Name))
+}
@@ -0,0 +1,70 @@
+package tui
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// workflowFileError is a YAML error of the workflow file at a line
+type workflowFileError struct {
+	Line    int // 1-based line number
+	Message string
+}
+
+// yamlErrorLineRegex extracts the line number from yaml.v3 error messages ("line 12: ...")
+var yamlErrorLineRegex = regexp.MustCompile(`line (\d+): (.*)`)
+
+// workflowSchema is the part of the workflow syntax checked for type errors
+type workflowSchema struct {
+	Name string `yaml:"name"`
+	Jobs map[string]struct {
+		Name  string `yaml:"name"`
+		Steps []struct {
+			ID   string `yaml:"id"`
+			Name string `yaml:"name"`
+			Uses string `yaml:"uses"`
+			Run  string `yaml:"run"`
+		} `yaml:"steps"`
+	} `yaml:"jobs"`
+}
+
+// validateWorkflowYAML parses the workflow file and returns its syntax and type errors
+func validateWorkflowYAML(content string) []workflowFileError {
+	var workflow workflowSchema
+	err := yaml.Unmarshal([]byte(content), &workflow)
+	if err == nil {
+		return nil
+	}
+
+	messages := []string{err.Error()}
+	var typeErr *yaml.TypeError
+	if errors.As(err, &typeErr) {
+		messages = typeErr.Errors
+	}
+
+	var result []workflowFileError
+	for _, message := range messages {
+		message = strings.TrimPrefix(message, "yaml: ")
+		fileErr := workflowFileError{Message: message}
+		if m := yamlErrorLineRegex.FindStringSubmatch(message); m != nil {
+			fileErr.Line, _ = strconv.Atoi(m[1])
+			fileErr.Message = m[2]
+		}
+		result = append(result, fileErr)
+	}
+	return result
+}
+
+// workflowFileErrorAt returns the first error at the 1-based line number, or nil
+func (a *App) workflowFileErrorAt(line int) *workflowFileError {
+	for i := range a.workflowFileErrors {
+		if a.workflowFileErrors[i].Line == line {
+			return &a.workflowFileErrors[i]
+		}
+	}
+	return nil
+}
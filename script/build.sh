@@ -0,0 +1,33 @@
+#!/usr/bin/env bash
+# cli/gh-extension-precompile の build_script_override から呼ばれる
+# リリースタグを --version / version サブコマンドに埋め込んでビルドする
+set -euo pipefail
+
+release_tag="${1:-${release_tag:?release tag is required}}"
+ldflags="-s -w -X github.com/ryo246912/gh-actions-dash/cmd.version=${release_tag}"
+
+platforms=(
+  darwin-amd64
+  darwin-arm64
+  freebsd-386
+  freebsd-amd64
+  freebsd-arm64
+  linux-386
+  linux-amd64
+  linux-arm
+  linux-arm64
+  windows-386
+  windows-amd64
+  windows-arm64
+)
+
+mkdir -p dist
+for platform in "${platforms[@]}"; do
+  goos="${platform%-*}"
+  goarch="${platform#*-}"
+  ext=""
+  if [ "$goos" = "windows" ]; then
+    ext=".exe"
+  fi
+  GOOS="$goos" GOARCH="$goarch" CGO_ENABLED=0 go build -trimpath -ldflags "$ldflags" -o "dist/${platform}${ext}" .
+done